@@ -0,0 +1,351 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/barugoo/oscillo-auth/api/grpc"
+)
+
+const (
+	formatJSONL = "jsonl"
+	formatCSV   = "csv"
+
+	maxLineSize = 1 << 20
+)
+
+var errUnknownFormat = errors.New("unknown format, use jsonl or csv")
+
+// columns are the JSONL keys and the CSV header of an account record.
+var columns = []string{
+	"id",
+	"email",
+	"password_hash",
+	"secret_2fa",
+	"locale",
+	"email_verified",
+	"status",
+	"status_reason",
+	"status_expires_at",
+	"created_at",
+}
+
+// record is an account as stored in import and export files. Times are
+// RFC 3339 and may be left empty.
+type record struct {
+	ID              string `json:"id,omitempty"`
+	Email           string `json:"email"`
+	PasswordHash    string `json:"password_hash"`
+	Secret2FA       string `json:"secret_2fa,omitempty"`
+	Locale          string `json:"locale,omitempty"`
+	EmailVerified   bool   `json:"email_verified"`
+	Status          string `json:"status,omitempty"`
+	StatusReason    string `json:"status_reason,omitempty"`
+	StatusExpiresAt string `json:"status_expires_at,omitempty"`
+	CreatedAt       string `json:"created_at,omitempty"`
+}
+
+func (r *record) toProto() (*pb.AccountRecord, error) {
+	statusExpiresAt, err := parseTime(r.StatusExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("status_expires_at: %v", err)
+	}
+	createdAt, err := parseTime(r.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("created_at: %v", err)
+	}
+
+	return &pb.AccountRecord{
+		Id:              r.ID,
+		Email:           r.Email,
+		PasswordHash:    r.PasswordHash,
+		Secret_2Fa:      r.Secret2FA,
+		Locale:          r.Locale,
+		EmailVerified:   r.EmailVerified,
+		Status:          r.Status,
+		StatusReason:    r.StatusReason,
+		StatusExpiresAt: statusExpiresAt,
+		CreatedAt:       createdAt,
+	}, nil
+}
+
+func recordFromProto(r *pb.AccountRecord) *record {
+	return &record{
+		ID:              r.Id,
+		Email:           r.Email,
+		PasswordHash:    r.PasswordHash,
+		Secret2FA:       r.Secret_2Fa,
+		Locale:          r.Locale,
+		EmailVerified:   r.EmailVerified,
+		Status:          r.Status,
+		StatusReason:    r.StatusReason,
+		StatusExpiresAt: formatTime(r.StatusExpiresAt),
+		CreatedAt:       formatTime(r.CreatedAt),
+	}
+}
+
+func (r *record) values() []string {
+	return []string{
+		r.ID,
+		r.Email,
+		r.PasswordHash,
+		r.Secret2FA,
+		r.Locale,
+		strconv.FormatBool(r.EmailVerified),
+		r.Status,
+		r.StatusReason,
+		r.StatusExpiresAt,
+		r.CreatedAt,
+	}
+}
+
+func (r *record) set(column, value string) error {
+	var err error
+	switch column {
+	case "id":
+		r.ID = value
+	case "email":
+		r.Email = value
+	case "password_hash":
+		r.PasswordHash = value
+	case "secret_2fa":
+		r.Secret2FA = value
+	case "locale":
+		r.Locale = value
+	case "email_verified":
+		if value != "" {
+			r.EmailVerified, err = strconv.ParseBool(value)
+		}
+	case "status":
+		r.Status = value
+	case "status_reason":
+		r.StatusReason = value
+	case "status_expires_at":
+		r.StatusExpiresAt = value
+	case "created_at":
+		r.CreatedAt = value
+	default:
+		err = errors.New("unknown column")
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %v", column, err)
+	}
+	return nil
+}
+
+func isColumn(name string) bool {
+	for _, column := range columns {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTime(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Unix(), nil
+}
+
+func formatTime(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
+
+// rowError is a record that couldn't be parsed. Reading can go on after it.
+type rowError struct {
+	row int64
+	err error
+}
+
+func (e *rowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.row, e.err)
+}
+
+type recordReader interface {
+	// Read returns the next record and its row in the file, a *rowError for
+	// a malformed record or io.EOF at the end.
+	Read() (int64, *pb.AccountRecord, error)
+}
+
+type recordWriter interface {
+	Write(r *record) error
+	Flush() error
+}
+
+func newRecordReader(format string, r io.Reader) (recordReader, error) {
+	switch format {
+	case formatJSONL:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+		return &jsonlReader{scanner: scanner}, nil
+	case formatCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		return &csvReader{reader: reader}, nil
+	default:
+		return nil, errUnknownFormat
+	}
+}
+
+func newRecordWriter(format string, w io.Writer) (recordWriter, error) {
+	switch format {
+	case formatJSONL:
+		buf := bufio.NewWriter(w)
+		return &jsonlWriter{buf: buf, encoder: json.NewEncoder(buf)}, nil
+	case formatCSV:
+		return &csvWriter{writer: csv.NewWriter(w)}, nil
+	default:
+		return nil, errUnknownFormat
+	}
+}
+
+type jsonlReader struct {
+	scanner *bufio.Scanner
+	row     int64
+}
+
+func (r *jsonlReader) Read() (int64, *pb.AccountRecord, error) {
+	for r.scanner.Scan() {
+		r.row++
+
+		line := strings.TrimSpace(r.scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var rec record
+		decoder := json.NewDecoder(strings.NewReader(line))
+		decoder.DisallowUnknownFields()
+		err := decoder.Decode(&rec)
+		if err != nil {
+			return r.row, nil, &rowError{row: r.row, err: err}
+		}
+
+		account, err := rec.toProto()
+		if err != nil {
+			return r.row, nil, &rowError{row: r.row, err: err}
+		}
+		return r.row, account, nil
+	}
+
+	err := r.scanner.Err()
+	if err == nil {
+		err = io.EOF
+	}
+	return r.row, nil, err
+}
+
+// csvReader takes the columns from the header row, so they may come in any
+// order and optional ones may be left out.
+type csvReader struct {
+	reader *csv.Reader
+	header []string
+	row    int64
+}
+
+func (r *csvReader) Read() (int64, *pb.AccountRecord, error) {
+	if r.header == nil {
+		header, err := r.reader.Read()
+		if err != nil {
+			return 0, nil, err
+		}
+		r.row++
+
+		for _, column := range header {
+			if !isColumn(column) {
+				return r.row, nil, fmt.Errorf("header: unknown column %q", column)
+			}
+		}
+		r.header = header
+	}
+
+	values, err := r.reader.Read()
+	if err == io.EOF {
+		return r.row, nil, err
+	}
+	r.row++
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return r.row, nil, &rowError{row: r.row, err: err}
+	}
+	if err != nil {
+		return r.row, nil, err
+	}
+	if len(values) != len(r.header) {
+		return r.row, nil, &rowError{row: r.row, err: fmt.Errorf("got %d fields, header has %d", len(values), len(r.header))}
+	}
+
+	var rec record
+	for i, value := range values {
+		err = rec.set(r.header[i], value)
+		if err != nil {
+			return r.row, nil, &rowError{row: r.row, err: err}
+		}
+	}
+
+	account, err := rec.toProto()
+	if err != nil {
+		return r.row, nil, &rowError{row: r.row, err: err}
+	}
+	return r.row, account, nil
+}
+
+type jsonlWriter struct {
+	buf     *bufio.Writer
+	encoder *json.Encoder
+}
+
+func (w *jsonlWriter) Write(r *record) error {
+	return w.encoder.Encode(r)
+}
+
+func (w *jsonlWriter) Flush() error {
+	return w.buf.Flush()
+}
+
+type csvWriter struct {
+	writer      *csv.Writer
+	wroteHeader bool
+}
+
+func (w *csvWriter) Write(r *record) error {
+	if !w.wroteHeader {
+		err := w.writer.Write(columns)
+		if err != nil {
+			return err
+		}
+		w.wroteHeader = true
+	}
+	return w.writer.Write(r.values())
+}
+
+// Flush writes the header even if no account was exported, so the file can
+// still be read back.
+func (w *csvWriter) Flush() error {
+	if !w.wroteHeader {
+		err := w.writer.Write(columns)
+		if err != nil {
+			return err
+		}
+		w.wroteHeader = true
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
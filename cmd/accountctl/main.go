@@ -0,0 +1,245 @@
+// Command accountctl moves accounts in and out of the auth service through
+// the AdminService import and export RPCs.
+//
+//	accountctl [-addr host:port] import [-format jsonl|csv] FILE
+//	accountctl [-addr host:port] export [-format jsonl|csv] [-out FILE] [filters]
+//
+// The admin API key is read from ACCOUNTCTL_API_KEY, the address defaults to
+// ACCOUNTCTL_ADDR.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/barugoo/oscillo-auth/api/grpc"
+)
+
+const (
+	apiKeyHeader = "x-api-key"
+
+	apiKeyEnv = "ACCOUNTCTL_API_KEY"
+	addrEnv   = "ACCOUNTCTL_ADDR"
+)
+
+func main() {
+	log.SetFlags(0)
+
+	addr := flag.String("addr", os.Getenv(addrEnv), "auth service gRPC address")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() < 1 || *addr == "" {
+		usage()
+		os.Exit(2)
+	}
+
+	conn, err := grpc.Dial(*addr, grpc.WithInsecure())
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer conn.Close()
+
+	client := pb.NewAdminServiceClient(conn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), apiKeyHeader, os.Getenv(apiKeyEnv))
+
+	switch cmd, args := flag.Arg(0), flag.Args()[1:]; cmd {
+	case "import":
+		err = runImport(ctx, client, args)
+	case "export":
+		err = runExport(ctx, client, args)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `usage: accountctl [-addr host:port] import [-format jsonl|csv] FILE
+       accountctl [-addr host:port] export [-format jsonl|csv] [-out FILE] [filters]
+
+The admin API key is read from %s.
+`, apiKeyEnv)
+	flag.PrintDefaults()
+}
+
+var errRowsFailed = errors.New("some rows failed to import")
+
+// runImport sends every record of the file and prints one line per rejected
+// row, whether the file couldn't be parsed there or the service refused it.
+func runImport(ctx context.Context, client pb.AdminServiceClient, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	format := fs.String("format", "", "file format, jsonl or csv (default from the file extension)")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	path := fs.Arg(0)
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader, err := newRecordReader(formatOf(*format, path), f)
+	if err != nil {
+		return err
+	}
+
+	// cancelling stops the import on a broken file, rows sent before stay
+	// imported
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.ImportAccounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	var parseErrors int64
+	for {
+		row, account, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var rowErr *rowError
+		if errors.As(err, &rowErr) {
+			parseErrors++
+			log.Println(rowErr)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(&pb.ImportAccountsRequest{
+			Row:     row,
+			Account: account,
+		})
+		if err != nil {
+			// the actual error comes with the response
+			break
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	for _, e := range resp.Errors {
+		log.Printf("row %d: %s: %s: %s", e.Row, e.Email, e.Code, e.Message)
+	}
+	log.Printf("imported %d, failed %d", resp.Imported, resp.Failed+parseErrors)
+
+	if resp.Failed+parseErrors > 0 {
+		return errRowsFailed
+	}
+	return nil
+}
+
+func runExport(ctx context.Context, client pb.AdminServiceClient, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "", "file format, jsonl or csv (default from the file extension, jsonl for stdout)")
+	out := fs.String("out", "", "output file (default stdout)")
+	status := fs.String("status", "", "only accounts with this status")
+	twoFactor := fs.String("2fa", "any", "only accounts with 2FA enabled or disabled, or any")
+	createdAfter := fs.String("created-after", "", "only accounts created at or after this RFC 3339 time")
+	createdBefore := fs.String("created-before", "", "only accounts created before this RFC 3339 time")
+	emailPrefix := fs.String("email-prefix", "", "only accounts whose email starts with this")
+	fs.Parse(args)
+
+	req := &pb.ExportAccountsRequest{
+		Status:      *status,
+		EmailPrefix: *emailPrefix,
+	}
+
+	tf, ok := pb.TwoFactorFilter_value[strings.ToUpper(*twoFactor)]
+	if !ok {
+		return fmt.Errorf("invalid -2fa %q", *twoFactor)
+	}
+	req.TwoFactor = pb.TwoFactorFilter(tf)
+
+	var err error
+	req.CreatedAfter, err = parseTime(*createdAfter)
+	if err != nil {
+		return fmt.Errorf("invalid -created-after: %v", err)
+	}
+	req.CreatedBefore, err = parseTime(*createdBefore)
+	if err != nil {
+		return fmt.Errorf("invalid -created-before: %v", err)
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	writer, err := newRecordWriter(formatOf(*format, *out), w)
+	if err != nil {
+		return err
+	}
+
+	stream, err := client.ExportAccounts(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	var n int
+	for {
+		account, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		err = writer.Write(recordFromProto(account))
+		if err != nil {
+			return err
+		}
+		n++
+	}
+
+	err = writer.Flush()
+	if err != nil {
+		return err
+	}
+	log.Printf("exported %d accounts in %s", n, time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+func formatOf(format, path string) string {
+	if format != "" {
+		return strings.ToLower(format)
+	}
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return formatCSV
+	}
+	return formatJSONL
+}
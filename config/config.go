@@ -1,16 +1,118 @@
 package config
 
 import (
+	"time"
+
 	"github.com/kelseyhightower/envconfig"
 )
 
 type ServiceConfig struct {
 	ServiceName string `envconfig:"service_name"`
 	Issuer2FA   string `envconfig:"issuer_2fa"`
-	AppSecret   string `envconfig:"app_secret"`
 	GRPCAddr    string `envconfig:"grpc_addr"`
+	HTTPAddr    string `envconfig:"http_addr"`
 	MongoAddr   string `envconfig:"mongo_addr"`
-	RedisAddr   string `envconfig:"mongo_addr"`
+	RedisAddr   string `envconfig:"redis_addr"`
+
+	SigningAlgorithm   string        `envconfig:"signing_algorithm" default:"RS256"`
+	KeyRotationPeriod  time.Duration `envconfig:"key_rotation_period" default:"720h"`
+	KeyRetentionPeriod time.Duration `envconfig:"key_retention_period" default:"24h"`
+	KeyRefreshInterval time.Duration `envconfig:"key_refresh_interval" default:"1m"`
+	// JWKSMaxAge is how long clients may cache the JWKS. A new key is
+	// published this long, plus KeyRefreshInterval for the other instances
+	// to load it, before it starts signing.
+	JWKSMaxAge time.Duration `envconfig:"jwks_max_age" default:"5m"`
+	// SigningKeyEncryptionKeys maps versions to base64 encoded 32 byte AES
+	// keys, e.g. "v1:<key>,v2:<key>". Private signing keys are encrypted with
+	// SigningKeyEncryptionVersion before they're stored, the other versions
+	// are kept to decrypt older keys. The service doesn't start without one.
+	SigningKeyEncryptionKeys    map[string]string `envconfig:"signing_key_encryption_keys"`
+	SigningKeyEncryptionVersion string            `envconfig:"signing_key_encryption_version"`
+
+	TokenIssuer    string        `envconfig:"token_issuer"`
+	TokenAudience  string        `envconfig:"token_audience"`
+	AccessTokenTTL time.Duration `envconfig:"access_token_ttl" default:"15m"`
+
+	RefreshTokenTTL time.Duration `envconfig:"refresh_token_ttl" default:"720h"`
+	ChallengeTTL    time.Duration `envconfig:"challenge_ttl" default:"5m"`
+	// SessionIdleTimeout ends sessions whose tokens weren't used for that
+	// long, 0 keeps them until the refresh token expires.
+	SessionIdleTimeout time.Duration `envconfig:"session_idle_timeout" default:"168h"`
+
+	// ReauthMaxAttempts limits the password and 2FA code checks made for an
+	// account outside of login within ReauthAttemptWindow.
+	ReauthMaxAttempts   int           `envconfig:"reauth_max_attempts" default:"5"`
+	ReauthAttemptWindow time.Duration `envconfig:"reauth_attempt_window" default:"15m"`
+
+	ValidateBatchSize int `envconfig:"validate_batch_size" default:"100"`
+
+	PasswordHashAlgorithm string `envconfig:"password_hash_algorithm" default:"argon2id"`
+	Argon2Time            uint32 `envconfig:"argon2_time" default:"3"`
+	Argon2Memory          uint32 `envconfig:"argon2_memory" default:"65536"`
+	Argon2Threads         uint8  `envconfig:"argon2_threads" default:"2"`
+	BcryptCost            int    `envconfig:"bcrypt_cost" default:"12"`
+	// Imported hashes with a higher work factor are rejected, verifying them
+	// on every login would be a way to exhaust the service.
+	Argon2MaxTime    uint32 `envconfig:"argon2_max_time" default:"10"`
+	Argon2MaxMemory  uint32 `envconfig:"argon2_max_memory" default:"262144"`
+	Argon2MaxThreads uint8  `envconfig:"argon2_max_threads" default:"8"`
+	BcryptMaxCost    int    `envconfig:"bcrypt_max_cost" default:"14"`
+
+	// PasswordPeppers maps pepper versions to secrets, e.g. "v1:secret,v2:secret".
+	// New hashes use PasswordPepperVersion, the others are kept for verification.
+	PasswordPeppers       map[string]string `envconfig:"password_peppers"`
+	PasswordPepperVersion string            `envconfig:"password_pepper_version"`
+
+	PasswordMinLength        int      `envconfig:"password_min_length" default:"8"`
+	PasswordMaxLength        int      `envconfig:"password_max_length" default:"128"`
+	PasswordRequireUpper     bool     `envconfig:"password_require_upper" default:"true"`
+	PasswordRequireLower     bool     `envconfig:"password_require_lower" default:"true"`
+	PasswordRequireDigit     bool     `envconfig:"password_require_digit" default:"true"`
+	PasswordRequireSymbol    bool     `envconfig:"password_require_symbol" default:"false"`
+	PasswordBannedSubstrings []string `envconfig:"password_banned_substrings"`
+	BreachedPasswordsFile    string   `envconfig:"breached_passwords_file"`
+	PasswordHistoryDepth     int      `envconfig:"password_history_depth" default:"5"`
+
+	PasswordResetTTL time.Duration `envconfig:"password_reset_ttl" default:"1h"`
+	PasswordResetURL string        `envconfig:"password_reset_url"`
+	// PasswordResetInterval is the least time between two reset mails for
+	// the same email.
+	PasswordResetInterval time.Duration `envconfig:"password_reset_interval" default:"1m"`
+
+	EmailVerificationTTL       time.Duration `envconfig:"email_verification_ttl" default:"24h"`
+	EmailVerificationURL       string        `envconfig:"email_verification_url"`
+	VerificationResendInterval time.Duration `envconfig:"verification_resend_interval" default:"1m"`
+
+	// EmailProviderRules collapses address variants known providers deliver to
+	// one mailbox, e.g. dots and +tags in gmail addresses.
+	EmailProviderRules bool `envconfig:"email_provider_rules" default:"false"`
+
+	EmailChangeTTL time.Duration `envconfig:"email_change_ttl" default:"24h"`
+	EmailChangeURL string        `envconfig:"email_change_url"`
+	EmailRevertTTL time.Duration `envconfig:"email_revert_ttl" default:"168h"`
+	EmailRevertURL string        `envconfig:"email_revert_url"`
+
+	AdminAPIKeys []string `envconfig:"admin_api_keys"`
+
+	// MailerDriver is log, file or smtp. It has no default, the service
+	// doesn't start without it.
+	MailerDriver  string `envconfig:"mailer_driver"`
+	MailFrom      string `envconfig:"mail_from" default:"no-reply@localhost"`
+	MailOutboxDir string `envconfig:"mail_outbox_dir" default:"outbox"`
+	SMTPAddr      string `envconfig:"smtp_addr"`
+	SMTPUsername  string `envconfig:"smtp_username"`
+	SMTPPassword  string `envconfig:"smtp_password"`
+
+	EventStream        string        `envconfig:"event_stream" default:"account_events"`
+	EventStreamMaxLen  int64         `envconfig:"event_stream_max_len" default:"100000"`
+	OutboxPollInterval time.Duration `envconfig:"outbox_poll_interval" default:"1s"`
+	OutboxBatchSize    int           `envconfig:"outbox_batch_size" default:"100"`
+	OutboxLockTTL      time.Duration `envconfig:"outbox_lock_ttl" default:"30s"`
+	OutboxRetention    time.Duration `envconfig:"outbox_retention" default:"168h"`
+
+	AccountDeletionGracePeriod time.Duration `envconfig:"account_deletion_grace_period" default:"720h"`
+	AccountPurgeInterval       time.Duration `envconfig:"account_purge_interval" default:"1h"`
+	AccountPurgeBatchSize      int           `envconfig:"account_purge_batch_size" default:"100"`
 }
 
 func NewConfig() (*ServiceConfig, error) {
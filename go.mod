@@ -21,5 +21,7 @@ require (
 	go.mongodb.org/mongo-driver v1.1.3
 	go.uber.org/atomic v1.5.0 // indirect
 	golang.org/x/crypto v0.0.0-20191117063200-497ca9f6d64f
+	golang.org/x/text v0.3.0
+	google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55
 	google.golang.org/grpc v1.25.1
 )
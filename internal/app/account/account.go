@@ -1,18 +1,127 @@
 package account
 
+import (
+	"time"
+
+	"github.com/barugoo/oscillo-auth/internal/app/errors"
+)
+
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusActive    Status = "active"
+	StatusSuspended Status = "suspended"
+	StatusLocked    Status = "locked"
+	StatusDeleted   Status = "deleted"
+)
+
 type Account struct {
-	ID           string `json:"id"`
+	// ID is generated by the repository on creation and never changes.
+	ID           string `json:"id" bson:"_id"`
 	Email        string `json:"email"`
 	PasswordHash string `json:"password_hash"`
 	Secret2FA    string `json:"secret_2fa"`
-	IsActive     bool   `json:"is_active"`
+	Locale       string `json:"locale"`
+
+	EmailVerified bool `json:"email_verified"`
+
+	// Status is changed together with the reason for it, who changed it and,
+	// for suspensions and locks, when it's lifted again.
+	Status          Status    `json:"status"`
+	StatusReason    string    `json:"status_reason,omitempty"`
+	StatusActor     string    `json:"status_actor,omitempty"`
+	StatusChangedAt time.Time `json:"status_changed_at"`
+	StatusExpiresAt time.Time `json:"status_expires_at"`
+
+	// PasswordHistory holds previous password hashes, newest first.
+	PasswordHistory []string `json:"password_history"`
+
+	CreatedAt time.Time `json:"created_at"`
+
+	// DeletedAt is set once deletion was requested. The account gets purged
+	// at PurgeAt unless the deletion is cancelled before.
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at"`
+
+	// Version is bumped by every update, which only applies to the version
+	// it was read at.
+	Version int64 `json:"-"`
 }
 
 func (a *Account) Has2FA() bool {
 	return len(a.Secret2FA) > 0
 }
 
+func (a *Account) IsDeleted() bool {
+	return a.Status == StatusDeleted
+}
+
+// CurrentStatus is Status with expired suspensions and locks already lifted.
+func (a *Account) CurrentStatus(now time.Time) Status {
+	switch a.Status {
+	case "":
+		return StatusPending
+	case StatusSuspended, StatusLocked:
+		if !a.StatusExpiresAt.IsZero() && !now.Before(a.StatusExpiresAt) {
+			return a.BaseStatus()
+		}
+	}
+	return a.Status
+}
+
+// BaseStatus is the status the account returns to once a restriction ends.
+func (a *Account) BaseStatus() Status {
+	if a.EmailVerified {
+		return StatusActive
+	}
+	return StatusPending
+}
+
+func (a *Account) SetStatus(status Status, reason, actor string, expiresAt time.Time) {
+	a.Status = status
+	a.StatusReason = reason
+	a.StatusActor = actor
+	a.StatusChangedAt = time.Now().UTC()
+	a.StatusExpiresAt = expiresAt
+}
+
+// CheckStatus returns the error telling why the account can't be used, or
+// nil if it's active.
+func (a *Account) CheckStatus(now time.Time) error {
+	switch a.CurrentStatus(now) {
+	case StatusActive:
+		return nil
+	case StatusSuspended:
+		return errors.ErrAccountSuspended
+	case StatusLocked:
+		return errors.ErrAccountLocked
+	case StatusDeleted:
+		return errors.ErrAccountDeleted
+	default:
+		return errors.ErrAccountPending
+	}
+}
+
+type Suspension struct {
+	Lock      bool
+	Reason    string
+	Actor     string
+	ExpiresAt time.Time
+}
+
+// AccountFilter selects accounts for admin listings. Zero fields don't
+// restrict the result.
+type AccountFilter struct {
+	Status        Status
+	Has2FA        *bool
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	EmailPrefix   string
+}
+
 type Credentials struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	Locale   string `json:"locale,omitempty"`
 }
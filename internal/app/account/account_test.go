@@ -0,0 +1,86 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/barugoo/oscillo-auth/internal/app/errors"
+)
+
+func TestCurrentStatus(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		account Account
+		want    Status
+		wantErr error
+	}{
+		{
+			name:    "no status",
+			account: Account{},
+			want:    StatusPending,
+			wantErr: errors.ErrAccountPending,
+		},
+		{
+			name:    "pending",
+			account: Account{Status: StatusPending},
+			want:    StatusPending,
+			wantErr: errors.ErrAccountPending,
+		},
+		{
+			name:    "active",
+			account: Account{Status: StatusActive, EmailVerified: true},
+			want:    StatusActive,
+		},
+		{
+			name:    "suspended indefinitely",
+			account: Account{Status: StatusSuspended, EmailVerified: true},
+			want:    StatusSuspended,
+			wantErr: errors.ErrAccountSuspended,
+		},
+		{
+			name:    "suspension running",
+			account: Account{Status: StatusSuspended, EmailVerified: true, StatusExpiresAt: now.Add(time.Second)},
+			want:    StatusSuspended,
+			wantErr: errors.ErrAccountSuspended,
+		},
+		{
+			name:    "suspension ends now",
+			account: Account{Status: StatusSuspended, EmailVerified: true, StatusExpiresAt: now},
+			want:    StatusActive,
+		},
+		{
+			name:    "suspension over, unverified",
+			account: Account{Status: StatusSuspended, StatusExpiresAt: now.Add(-time.Hour)},
+			want:    StatusPending,
+			wantErr: errors.ErrAccountPending,
+		},
+		{
+			name:    "lock running",
+			account: Account{Status: StatusLocked, EmailVerified: true, StatusExpiresAt: now.Add(time.Hour)},
+			want:    StatusLocked,
+			wantErr: errors.ErrAccountLocked,
+		},
+		{
+			name:    "lock over",
+			account: Account{Status: StatusLocked, EmailVerified: true, StatusExpiresAt: now.Add(-time.Hour)},
+			want:    StatusActive,
+		},
+		{
+			name:    "deleted",
+			account: Account{Status: StatusDeleted, EmailVerified: true, StatusExpiresAt: now.Add(-time.Hour)},
+			want:    StatusDeleted,
+			wantErr: errors.ErrAccountDeleted,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := tt.account.CurrentStatus(now); got != tt.want {
+			t.Errorf("%s: CurrentStatus() = %q, want %q", tt.name, got, tt.want)
+		}
+		if err := tt.account.CheckStatus(now); err != tt.wantErr {
+			t.Errorf("%s: CheckStatus() = %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+package delivery
+
+import (
+	"context"
+	"crypto/subtle"
+	"io"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/barugoo/oscillo-auth/api/grpc"
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/account"
+	"github.com/barugoo/oscillo-auth/internal/app/account/usecase"
+)
+
+const (
+	apiKeyHeader = "x-api-key"
+
+	exportPageSize = 500
+)
+
+// adminMethods are the privileged RPCs. An entry ending in "/" covers every
+// method of that service.
+var adminMethods = []string{
+	"/Auth.Auth/UpdateCredentials",
+	"/Auth.Auth/ActivateAccount",
+	"/Auth.Auth/SuspendAccount",
+	"/Auth.Auth/UnsuspendAccount",
+	"/Auth.AdminService/",
+}
+
+// NewAdminInterceptor rejects calls to admin methods that don't carry one of
+// the configured API keys in the x-api-key metadata. With no keys configured
+// admin methods can't be called at all.
+func NewAdminInterceptor(keys []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isAdminMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		err := checkAPIKey(ctx, keys)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// NewAdminStreamInterceptor is NewAdminInterceptor for streaming methods.
+func NewAdminStreamInterceptor(keys []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !isAdminMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		err := checkAPIKey(ss.Context(), keys)
+		if err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func isAdminMethod(fullMethod string) bool {
+	for _, method := range adminMethods {
+		if method == fullMethod || strings.HasSuffix(method, "/") && strings.HasPrefix(fullMethod, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkAPIKey(ctx context.Context, keys []string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(apiKeyHeader)
+	if len(values) == 0 || values[0] == "" {
+		return status.Error(codes.Unauthenticated, errs.ErrMissingAPIKey.Error())
+	}
+
+	// every key is compared so timing doesn't depend on which one matched
+	valid := 0
+	for _, key := range keys {
+		if key != "" {
+			valid |= subtle.ConstantTimeCompare([]byte(values[0]), []byte(key))
+		}
+	}
+	if valid != 1 {
+		return status.Error(codes.PermissionDenied, errs.ErrInvalidAPIKey.Error())
+	}
+	return nil
+}
+
+// NewAdminGRPCServer serves the AdminService. It shares the handler helpers
+// of the Auth service, the admin interceptor guards all of its methods.
+func NewAdminGRPCServer(service service.AuthService, accountUsecase usecase.AccountUsecase) pb.AdminServiceServer {
+	return &authGRPCServer{
+		service:     service,
+		accountCase: accountUsecase,
+	}
+}
+
+func (auth *authGRPCServer) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.getAccount(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, req.Email))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) getAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	account, err := auth.accountCase.GetAccount(ctx, req.Id, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAccountResponse{
+		Account: accountView(account),
+	}, err
+}
+
+func (auth *authGRPCServer) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.listAccounts(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) listAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	filter := accountFilter(req.Status, req.TwoFactor, req.CreatedAfter, req.CreatedBefore, req.EmailPrefix)
+
+	accounts, next, err := auth.accountCase.ListAccounts(ctx, filter, req.Cursor, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]*pb.AccountView, 0, len(accounts))
+	for _, account := range accounts {
+		views = append(views, accountView(account))
+	}
+	return &pb.ListAccountsResponse{
+		Accounts:   views,
+		NextCursor: next,
+	}, err
+}
+
+func (auth *authGRPCServer) ImportAccounts(stream pb.AdminService_ImportAccountsServer) error {
+	ctx := stream.Context()
+
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	err = auth.importAccounts(methodCtx, stream)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return err
+}
+
+// importAccounts goes on past rejected records and reports each of them with
+// the status it would have got as a single call.
+func (auth *authGRPCServer) importAccounts(ctx context.Context, stream pb.AdminService_ImportAccountsServer) error {
+	resp := &pb.ImportAccountsResponse{}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(resp)
+		}
+		if err != nil {
+			return err
+		}
+
+		record := req.Account
+		if record == nil {
+			record = &pb.AccountRecord{}
+		}
+
+		_, err = auth.accountCase.ImportAccount(ctx, accountFromRecord(record))
+		if err != nil {
+			st := status.Convert(auth.grpcError(auth.wrapError(err, record.Email)))
+			resp.Failed++
+			resp.Errors = append(resp.Errors, &pb.ImportError{
+				Row:     req.Row,
+				Email:   record.Email,
+				Code:    st.Code().String(),
+				Message: st.Message(),
+			})
+			continue
+		}
+		resp.Imported++
+	}
+}
+
+func (auth *authGRPCServer) ExportAccounts(req *pb.ExportAccountsRequest, stream pb.AdminService_ExportAccountsServer) error {
+	ctx := stream.Context()
+
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	err = auth.exportAccounts(methodCtx, req, stream)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return err
+}
+
+func (auth *authGRPCServer) exportAccounts(ctx context.Context, req *pb.ExportAccountsRequest, stream pb.AdminService_ExportAccountsServer) error {
+	filter := accountFilter(req.Status, req.TwoFactor, req.CreatedAfter, req.CreatedBefore, req.EmailPrefix)
+
+	var cursor string
+	for {
+		accounts, next, err := auth.accountCase.ListAccounts(ctx, filter, cursor, exportPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			err = stream.Send(accountRecord(account))
+			if err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+func accountFilter(st string, twoFactor pb.TwoFactorFilter, createdAfter, createdBefore int64, emailPrefix string) *models.AccountFilter {
+	filter := &models.AccountFilter{
+		Status:      models.Status(st),
+		EmailPrefix: emailPrefix,
+	}
+	if createdAfter > 0 {
+		filter.CreatedAfter = time.Unix(createdAfter, 0).UTC()
+	}
+	if createdBefore > 0 {
+		filter.CreatedBefore = time.Unix(createdBefore, 0).UTC()
+	}
+	switch twoFactor {
+	case pb.TwoFactorFilter_ENABLED, pb.TwoFactorFilter_DISABLED:
+		has2FA := twoFactor == pb.TwoFactorFilter_ENABLED
+		filter.Has2FA = &has2FA
+	}
+	return filter
+}
+
+func accountFromRecord(record *pb.AccountRecord) *models.Account {
+	account := &models.Account{
+		ID:            record.Id,
+		Email:         record.Email,
+		PasswordHash:  record.PasswordHash,
+		Secret2FA:     record.Secret_2Fa,
+		Locale:        record.Locale,
+		EmailVerified: record.EmailVerified,
+		Status:        models.Status(record.Status),
+		StatusReason:  record.StatusReason,
+	}
+	if record.StatusExpiresAt > 0 {
+		account.StatusExpiresAt = time.Unix(record.StatusExpiresAt, 0).UTC()
+	}
+	if record.CreatedAt > 0 {
+		account.CreatedAt = time.Unix(record.CreatedAt, 0).UTC()
+	}
+	return account
+}
+
+// accountRecord, unlike accountView, carries the credentials. It's only
+// served to ExportAccounts callers.
+func accountRecord(account *models.Account) *pb.AccountRecord {
+	return &pb.AccountRecord{
+		Id:              account.ID,
+		Email:           account.Email,
+		PasswordHash:    account.PasswordHash,
+		Secret_2Fa:      account.Secret2FA,
+		Locale:          account.Locale,
+		EmailVerified:   account.EmailVerified,
+		Status:          string(account.CurrentStatus(time.Now())),
+		StatusReason:    account.StatusReason,
+		StatusExpiresAt: unixOrZero(account.StatusExpiresAt),
+		CreatedAt:       unixOrZero(account.CreatedAt),
+	}
+}
+
+// accountView leaves out the password hash, its history and the 2FA secret.
+func accountView(account *models.Account) *pb.AccountView {
+	return &pb.AccountView{
+		Id:              account.ID,
+		Email:           account.Email,
+		Locale:          account.Locale,
+		EmailVerified:   account.EmailVerified,
+		Status:          string(account.CurrentStatus(time.Now())),
+		StatusReason:    account.StatusReason,
+		StatusActor:     account.StatusActor,
+		StatusChangedAt: unixOrZero(account.StatusChangedAt),
+		StatusExpiresAt: unixOrZero(account.StatusExpiresAt),
+		Has_2Fa:         account.Has2FA(),
+		CreatedAt:       unixOrZero(account.CreatedAt),
+		DeletedAt:       unixOrZero(account.DeletedAt),
+		PurgeAt:         unixOrZero(account.PurgeAt),
+	}
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
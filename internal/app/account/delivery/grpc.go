@@ -4,8 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/barugoo/oscillo-auth/api/grpc"
@@ -14,21 +20,83 @@ import (
 
 	models "github.com/barugoo/oscillo-auth/internal/app/account"
 	"github.com/barugoo/oscillo-auth/internal/app/account/usecase"
+
+	sessionModels "github.com/barugoo/oscillo-auth/internal/app/session"
+	sessionUsecase "github.com/barugoo/oscillo-auth/internal/app/session/usecase"
+
+	tokenModels "github.com/barugoo/oscillo-auth/internal/app/token"
+	tokenUsecase "github.com/barugoo/oscillo-auth/internal/app/token/usecase"
 )
 
 const (
 	deliveryMethodTemplate = "%s/delivery"
+
+	userAgentHeader = "user-agent"
 )
 
+// statusCodes maps the errors callers can act on to gRPC codes. Anything
+// else coming from the layers below is Internal.
+var statusCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{errs.ErrNotFound, codes.NotFound},
+	{errs.ErrAlreadyExists, codes.AlreadyExists},
+
+	{errs.ErrWrongPassword, codes.Unauthenticated},
+	{errs.ErrInvalidCredentials, codes.Unauthenticated},
+	{errs.ErrInvalid2FACode, codes.Unauthenticated},
+	{errs.ErrInvalidRefreshToken, codes.Unauthenticated},
+	{errs.ErrRefreshTokenReused, codes.Unauthenticated},
+	{errs.ErrRefreshTokenRevoked, codes.Unauthenticated},
+	{errs.ErrInvalidToken, codes.Unauthenticated},
+	{errs.ErrTokenExpired, codes.Unauthenticated},
+	{errs.ErrTokenRevoked, codes.Unauthenticated},
+	{errs.ErrInvalidChallenge, codes.Unauthenticated},
+	{errs.ErrSessionRevoked, codes.Unauthenticated},
+	{errs.ErrInvalidResetToken, codes.Unauthenticated},
+	{errs.ErrInvalidVerificationToken, codes.Unauthenticated},
+	{errs.ErrInvalidEmailChangeToken, codes.Unauthenticated},
+
+	{errs.ErrEmailTaken, codes.AlreadyExists},
+
+	{errs.ErrInvalidEmail, codes.InvalidArgument},
+	{errs.ErrAccountNotSpecified, codes.InvalidArgument},
+	{errs.ErrInvalidPasswordHash, codes.InvalidArgument},
+	{errs.ErrInvalid2FASecret, codes.InvalidArgument},
+	{errs.ErrInvalidStatus, codes.InvalidArgument},
+	{errs.Err2FADisabled, codes.InvalidArgument},
+	{errs.ErrBatchTooLarge, codes.InvalidArgument},
+
+	{errs.ErrTooManyRequests, codes.ResourceExhausted},
+
+	{errs.ErrAccountNotSuspended, codes.FailedPrecondition},
+	{errs.ErrDeletionNotPending, codes.FailedPrecondition},
+
+	// every account state has a code of its own, so clients can tell what
+	// to do: verify the email, wait for the lock to lift and retry, cancel
+	// the deletion and log in again, or stop
+	{errs.ErrAccountPending, codes.FailedPrecondition},
+	{errs.ErrAccountLocked, codes.Unavailable},
+	{errs.ErrAccountDeleted, codes.Aborted},
+	{errs.ErrAccountSuspended, codes.PermissionDenied},
+
+	{errs.ErrSessionNotFound, codes.NotFound},
+}
+
 type authGRPCServer struct {
 	service     service.AuthService
 	accountCase usecase.AccountUsecase
+	tokenCase   tokenUsecase.TokenUsecase
+	sessionCase sessionUsecase.SessionUsecase
 }
 
-func NewAuthGRPCServer(service service.AuthService, accountUsecase usecase.AccountUsecase) pb.AuthServer {
+func NewAuthGRPCServer(service service.AuthService, accountUsecase usecase.AccountUsecase, tokenUsecase tokenUsecase.TokenUsecase, sessionUsecase sessionUsecase.SessionUsecase) pb.AuthServer {
 	return &authGRPCServer{
 		service:     service,
 		accountCase: accountUsecase,
+		tokenCase:   tokenUsecase,
+		sessionCase: sessionUsecase,
 	}
 }
 
@@ -55,13 +123,15 @@ func (auth *authGRPCServer) register(ctx context.Context, req *pb.RegisterReques
 	r := &models.Credentials{
 		Email:    req.Email,
 		Password: req.Password,
+		Locale:   req.Locale,
 	}
-	ok, err := auth.accountCase.RegisterWithCredentials(ctx, r)
+	account, err := auth.accountCase.RegisterWithCredentials(ctx, r)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.RegisterResponse{
-		Ok: ok,
+		Ok:        true,
+		AccountId: account.ID,
 	}, err
 }
 
@@ -77,24 +147,71 @@ func (auth *authGRPCServer) Login(ctx context.Context, req *pb.LoginRequest) (*p
 	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
 	methodCtx := auth.contextWithMethod(spanCtx, methodName)
 
-	resp, err := auth.login(methodCtx, req)
+	client := auth.getClientFromContext(ctx)
+
+	resp, err := auth.login(methodCtx, req, client)
 	if err != nil {
 		err = auth.grpcError(auth.wrapError(err, req.Email))
 	}
 	return resp, err
 }
 
-func (auth *authGRPCServer) login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+func (auth *authGRPCServer) login(ctx context.Context, req *pb.LoginRequest, client *sessionModels.ClientInfo) (*pb.LoginResponse, error) {
 	r := &models.Credentials{
 		Email:    req.Email,
 		Password: req.Password,
 	}
-	token, err := auth.accountCase.AuthByCredentials(ctx, r)
+	result, err := auth.accountCase.AuthByCredentials(ctx, r, client)
 	if err != nil {
 		return nil, err
 	}
+
+	if result.Challenge != nil {
+		return &pb.LoginResponse{
+			MfaRequired:  true,
+			MfaToken:     result.Challenge.Value,
+			MfaExpiresAt: result.Challenge.ExpiresAt.Unix(),
+		}, err
+	}
 	return &pb.LoginResponse{
-		Token: token,
+		Token:            result.Tokens.Access.Value,
+		ExpiresAt:        result.Tokens.Access.ExpiresAt.Unix(),
+		RefreshToken:     result.Tokens.Refresh.Value,
+		RefreshExpiresAt: result.Tokens.Refresh.ExpiresAt.Unix(),
+	}, err
+}
+
+func (auth *authGRPCServer) CompleteLogin(ctx context.Context, req *pb.CompleteLoginRequest) (*pb.CompleteLoginResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	client := auth.getClientFromContext(ctx)
+
+	resp, err := auth.completeLogin(methodCtx, req, client)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) completeLogin(ctx context.Context, req *pb.CompleteLoginRequest, client *sessionModels.ClientInfo) (*pb.CompleteLoginResponse, error) {
+	tokens, err := auth.accountCase.CompleteLogin(ctx, req.MfaToken, req.Code, client)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CompleteLoginResponse{
+		Token:            tokens.Access.Value,
+		ExpiresAt:        tokens.Access.ExpiresAt.Unix(),
+		RefreshToken:     tokens.Refresh.Value,
+		RefreshExpiresAt: tokens.Refresh.ExpiresAt.Unix(),
 	}, err
 }
 
@@ -131,6 +248,98 @@ func (auth *authGRPCServer) updateCredentials(ctx context.Context, req *pb.Updat
 	}, err
 }
 
+func (auth *authGRPCServer) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.changePassword(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) changePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	ok, err := auth.accountCase.ChangePassword(ctx, req.Token, req.CurrentPassword, req.NewPassword, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChangePasswordResponse{
+		Ok: ok,
+	}, err
+}
+
+func (auth *authGRPCServer) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.deleteAccount(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) deleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	purgeAt, err := auth.accountCase.DeleteAccount(ctx, req.Token, req.Password, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteAccountResponse{
+		Ok:      true,
+		PurgeAt: purgeAt.Unix(),
+	}, err
+}
+
+func (auth *authGRPCServer) CancelDeletion(ctx context.Context, req *pb.CancelDeletionRequest) (*pb.CancelDeletionResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.cancelDeletion(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, req.Email))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) cancelDeletion(ctx context.Context, req *pb.CancelDeletionRequest) (*pb.CancelDeletionResponse, error) {
+	r := &models.Credentials{
+		Email:    req.Email,
+		Password: req.Password,
+	}
+	ok, err := auth.accountCase.CancelDeletion(ctx, r, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CancelDeletionResponse{
+		Ok: ok,
+	}, err
+}
+
 func (auth *authGRPCServer) ActivateAccount(ctx context.Context, req *pb.ActivateAccountRequest) (*pb.ActivateAccountResponse, error) {
 	methodName, err := auth.getMethodFromContext(ctx)
 	if err != nil {
@@ -160,6 +369,131 @@ func (auth *authGRPCServer) activateAccount(ctx context.Context, req *pb.Activat
 	}, err
 }
 
+func (auth *authGRPCServer) SuspendAccount(ctx context.Context, req *pb.SuspendAccountRequest) (*pb.SuspendAccountResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.suspendAccount(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, req.Email))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) suspendAccount(ctx context.Context, req *pb.SuspendAccountRequest) (*pb.SuspendAccountResponse, error) {
+	r := &models.Suspension{
+		Lock:   req.Lock,
+		Reason: req.Reason,
+		Actor:  req.Actor,
+	}
+	if req.ExpiresAt > 0 {
+		r.ExpiresAt = time.Unix(req.ExpiresAt, 0).UTC()
+	}
+
+	ok, err := auth.accountCase.SuspendAccount(ctx, req.Email, r)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SuspendAccountResponse{
+		Ok: ok,
+	}, err
+}
+
+func (auth *authGRPCServer) UnsuspendAccount(ctx context.Context, req *pb.UnsuspendAccountRequest) (*pb.UnsuspendAccountResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.unsuspendAccount(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, req.Email))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) unsuspendAccount(ctx context.Context, req *pb.UnsuspendAccountRequest) (*pb.UnsuspendAccountResponse, error) {
+	ok, err := auth.accountCase.UnsuspendAccount(ctx, req.Email, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnsuspendAccountResponse{
+		Ok: ok,
+	}, err
+}
+
+func (auth *authGRPCServer) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.verifyEmail(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) verifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	ok, err := auth.accountCase.VerifyEmail(ctx, req.VerificationToken)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.VerifyEmailResponse{
+		Ok: ok,
+	}, err
+}
+
+func (auth *authGRPCServer) ResendVerification(ctx context.Context, req *pb.ResendVerificationRequest) (*pb.ResendVerificationResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.resendVerification(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, req.Email))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) resendVerification(ctx context.Context, req *pb.ResendVerificationRequest) (*pb.ResendVerificationResponse, error) {
+	err := auth.accountCase.ResendVerification(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResendVerificationResponse{
+		Ok: true,
+	}, err
+}
+
 func (auth *authGRPCServer) Generate2FA(ctx context.Context, req *pb.Generate2FARequest) (*pb.Generate2FAResponse, error) {
 	methodName, err := auth.getMethodFromContext(ctx)
 	if err != nil {
@@ -174,13 +508,13 @@ func (auth *authGRPCServer) Generate2FA(ctx context.Context, req *pb.Generate2FA
 
 	resp, err := auth.generate2FA(methodCtx, req)
 	if err != nil {
-		err = auth.grpcError(auth.wrapError(err, req.Email))
+		err = auth.grpcError(auth.wrapError(err, ""))
 	}
 	return resp, err
 }
 
 func (auth *authGRPCServer) generate2FA(ctx context.Context, req *pb.Generate2FARequest) (*pb.Generate2FAResponse, error) {
-	img, err := auth.accountCase.Generate2FA(ctx, req.Email)
+	img, err := auth.accountCase.Generate2FA(ctx, req.Token, req.Password, req.Code)
 	if err != nil {
 		return nil, err
 	}
@@ -203,13 +537,13 @@ func (auth *authGRPCServer) Setup2FA(ctx context.Context, req *pb.Setup2FAReques
 
 	resp, err := auth.setup2FA(methodCtx, req)
 	if err != nil {
-		err = auth.grpcError(auth.wrapError(err, req.Email))
+		err = auth.grpcError(auth.wrapError(err, ""))
 	}
 	return resp, err
 }
 
 func (auth *authGRPCServer) setup2FA(ctx context.Context, req *pb.Setup2FARequest) (*pb.Setup2FAResponse, error) {
-	ok, err := auth.accountCase.Setup2FA(ctx, req.Email, req.Code)
+	ok, err := auth.accountCase.Setup2FA(ctx, req.Token, req.Password, req.CurrentCode, req.Code)
 	if err != nil {
 		return nil, err
 	}
@@ -232,13 +566,13 @@ func (auth *authGRPCServer) Disable2FA(ctx context.Context, req *pb.Disable2FARe
 
 	resp, err := auth.disable2FA(methodCtx, req)
 	if err != nil {
-		err = auth.grpcError(auth.wrapError(err, req.Email))
+		err = auth.grpcError(auth.wrapError(err, ""))
 	}
 	return resp, err
 }
 
 func (auth *authGRPCServer) disable2FA(ctx context.Context, req *pb.Disable2FARequest) (*pb.Disable2FAResponse, error) {
-	ok, err := auth.accountCase.Remove2FA(ctx, req.Email, req.Code)
+	ok, err := auth.accountCase.Remove2FA(ctx, req.Token, req.Password, req.Code)
 	if err != nil {
 		return nil, err
 	}
@@ -261,13 +595,13 @@ func (auth *authGRPCServer) Verify2FA(ctx context.Context, req *pb.Verify2FARequ
 
 	resp, err := auth.verify2FA(methodCtx, req)
 	if err != nil {
-		err = auth.grpcError(auth.wrapError(err, req.Email))
+		err = auth.grpcError(auth.wrapError(err, ""))
 	}
 	return resp, err
 }
 
 func (auth *authGRPCServer) verify2FA(ctx context.Context, req *pb.Verify2FARequest) (*pb.Verify2FAResponse, error) {
-	ok, err := auth.accountCase.Verify2FA(ctx, req.Email, req.Code)
+	ok, err := auth.accountCase.Verify2FA(ctx, req.Token, req.Code)
 	if err != nil {
 		return nil, err
 	}
@@ -276,60 +610,552 @@ func (auth *authGRPCServer) verify2FA(ctx context.Context, req *pb.Verify2FARequ
 	}, err
 }
 
-func (auth *authGRPCServer) contextWithMethod(ctx context.Context, method string) context.Context {
-	return context.WithValue(ctx, "method", method)
-}
+func (auth *authGRPCServer) GetJWKS(ctx context.Context, req *pb.GetJWKSRequest) (*pb.GetJWKSResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-func (auth *authGRPCServer) getMethodFromContext(ctx context.Context) (string, error) {
-	methodName, ok := grpc.Method(ctx)
-	if !ok {
-		return "", errs.ErrBrokenContext
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.getJWKS(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
 	}
-	return fmt.Sprintf(deliveryMethodTemplate, methodName), nil
+	return resp, err
 }
 
-func (auth *authGRPCServer) grpcError(err error) error {
-	return status.Error(auth.mapStatusCode(err), err.Error())
-}
+func (auth *authGRPCServer) getJWKS(ctx context.Context, req *pb.GetJWKSRequest) (*pb.GetJWKSResponse, error) {
+	jwks, err := auth.tokenCase.GetJWKS(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-func (auth *authGRPCServer) wrapError(err error, email string) error {
-	return &errs.DeliveryError{
-		Email: email,
-		Err:   err,
+	keys := make([]*pb.JWK, 0, len(jwks.Keys))
+	for _, key := range jwks.Keys {
+		keys = append(keys, &pb.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+			Y:   key.Y,
+		})
 	}
+	return &pb.GetJWKSResponse{
+		Keys: keys,
+	}, err
 }
 
-func (auth *authGRPCServer) mapStatusCode(err error) codes.Code {
-	var repErr *errs.RepositoryError
-	if errors.As(err, &repErr) {
-		switch repErr.Err {
-		case errs.ErrNotFound:
-			return codes.NotFound
-		default:
-			return codes.Internal
-		}
+func (auth *authGRPCServer) Refresh(ctx context.Context, req *pb.RefreshRequest) (*pb.RefreshResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	var serviceErr *errs.ServiceError
-	if errors.As(err, &serviceErr) {
-		switch serviceErr.Err {
-		default:
-			return codes.Internal
-		}
-	}
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
 
-	var caseErr *errs.UsecaseError
-	if errors.As(err, &caseErr) {
-		switch caseErr.Err {
-		case errs.ErrWrongPassword, errs.ErrInvalid2FACode, errs.ErrInactiveAccount:
-			return codes.Unauthenticated
-		case errs.Err2FADisabled:
-			return codes.InvalidArgument
-		case errs.ErrUnableToStoreKey:
-			fallthrough
-		default:
-			return codes.Internal
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.refresh(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) refresh(ctx context.Context, req *pb.RefreshRequest) (*pb.RefreshResponse, error) {
+	tokens, err := auth.tokenCase.RefreshTokens(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RefreshResponse{
+		Token:            tokens.Access.Value,
+		ExpiresAt:        tokens.Access.ExpiresAt.Unix(),
+		RefreshToken:     tokens.Refresh.Value,
+		RefreshExpiresAt: tokens.Refresh.ExpiresAt.Unix(),
+	}, err
+}
+
+func (auth *authGRPCServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.logout(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	err := auth.tokenCase.Logout(ctx, req.Token, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LogoutResponse{
+		Ok: true,
+	}, err
+}
+
+func (auth *authGRPCServer) RevokeAllTokens(ctx context.Context, req *pb.RevokeAllTokensRequest) (*pb.RevokeAllTokensResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.revokeAllTokens(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) revokeAllTokens(ctx context.Context, req *pb.RevokeAllTokensRequest) (*pb.RevokeAllTokensResponse, error) {
+	err := auth.tokenCase.RevokeAllTokens(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevokeAllTokensResponse{
+		Ok: true,
+	}, err
+}
+
+func (auth *authGRPCServer) ValidateToken(ctx context.Context, req *pb.ValidateTokenRequest) (*pb.ValidateTokenResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.validateToken(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) validateToken(ctx context.Context, req *pb.ValidateTokenRequest) (*pb.ValidateTokenResponse, error) {
+	claims, err := auth.tokenCase.ValidateToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ValidateTokenResponse{
+		Claims: auth.claimsToProto(claims),
+	}, err
+}
+
+func (auth *authGRPCServer) ValidateTokens(ctx context.Context, req *pb.ValidateTokensRequest) (*pb.ValidateTokensResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.validateTokens(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) validateTokens(ctx context.Context, req *pb.ValidateTokensRequest) (*pb.ValidateTokensResponse, error) {
+	validations, err := auth.tokenCase.ValidateTokens(ctx, req.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.TokenValidation, 0, len(validations))
+	for _, validation := range validations {
+		result := &pb.TokenValidation{
+			Valid: validation.Err == nil,
 		}
+		if validation.Err != nil {
+			result.Code = uint32(auth.mapStatusCode(validation.Err))
+			result.Error = validation.Err.Error()
+		} else {
+			result.Claims = auth.claimsToProto(validation.Claims)
+		}
+		results = append(results, result)
+	}
+	return &pb.ValidateTokensResponse{
+		Results: results,
+	}, err
+}
+
+func (auth *authGRPCServer) claimsToProto(claims *tokenModels.Claims) *pb.TokenClaims {
+	return &pb.TokenClaims{
+		Id:        claims.Id,
+		Issuer:    claims.Issuer,
+		Subject:   claims.Subject,
+		Audience:  claims.Audience,
+		IssuedAt:  claims.IssuedAt,
+		NotBefore: claims.NotBefore,
+		ExpiresAt: claims.ExpiresAt,
+		Email:     claims.Email,
+		Has_2Fa:   claims.Has2FA,
+		SessionId: claims.SessionID,
+	}
+}
+
+func (auth *authGRPCServer) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.listSessions(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) listSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	sessions, err := auth.sessionCase.ListSessions(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListSessionsResponse{
+		Sessions: make([]*pb.Session, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &pb.Session{
+			Id:         session.ID,
+			CreatedAt:  session.CreatedAt.Unix(),
+			LastSeenAt: session.LastSeenAt.Unix(),
+			Ip:         session.IP,
+			UserAgent:  session.UserAgent,
+			Current:    session.Current,
+		})
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.revokeSession(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) revokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	ok, err := auth.sessionCase.RevokeSession(ctx, req.Token, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevokeSessionResponse{
+		Ok: ok,
+	}, err
+}
+
+func (auth *authGRPCServer) RevokeOtherSessions(ctx context.Context, req *pb.RevokeOtherSessionsRequest) (*pb.RevokeOtherSessionsResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.revokeOtherSessions(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) revokeOtherSessions(ctx context.Context, req *pb.RevokeOtherSessionsRequest) (*pb.RevokeOtherSessionsResponse, error) {
+	n, err := auth.sessionCase.RevokeOtherSessions(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevokeOtherSessionsResponse{
+		Revoked: n,
+	}, err
+}
+
+func (auth *authGRPCServer) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.requestPasswordReset(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, req.Email))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) requestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	err := auth.accountCase.RequestPasswordReset(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RequestPasswordResetResponse{
+		Ok: true,
+	}, err
+}
+
+func (auth *authGRPCServer) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.resetPassword(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) resetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	err := auth.accountCase.ResetPassword(ctx, req.ResetToken, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResetPasswordResponse{
+		Ok: true,
+	}, err
+}
+
+func (auth *authGRPCServer) RequestEmailChange(ctx context.Context, req *pb.RequestEmailChangeRequest) (*pb.RequestEmailChangeResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.requestEmailChange(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, req.NewEmail))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) requestEmailChange(ctx context.Context, req *pb.RequestEmailChangeRequest) (*pb.RequestEmailChangeResponse, error) {
+	err := auth.accountCase.RequestEmailChange(ctx, req.Token, req.NewEmail, req.Password, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RequestEmailChangeResponse{
+		Ok: true,
+	}, err
+}
+
+func (auth *authGRPCServer) ConfirmEmailChange(ctx context.Context, req *pb.ConfirmEmailChangeRequest) (*pb.ConfirmEmailChangeResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.confirmEmailChange(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) confirmEmailChange(ctx context.Context, req *pb.ConfirmEmailChangeRequest) (*pb.ConfirmEmailChangeResponse, error) {
+	ok, err := auth.accountCase.ConfirmEmailChange(ctx, req.ChangeToken)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ConfirmEmailChangeResponse{
+		Ok: ok,
+	}, err
+}
+
+func (auth *authGRPCServer) RevertEmailChange(ctx context.Context, req *pb.RevertEmailChangeRequest) (*pb.RevertEmailChangeResponse, error) {
+	methodName, err := auth.getMethodFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span := auth.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	spanCtx := auth.service.ContextWithSpan(context.Background(), span)
+	methodCtx := auth.contextWithMethod(spanCtx, methodName)
+
+	resp, err := auth.revertEmailChange(methodCtx, req)
+	if err != nil {
+		err = auth.grpcError(auth.wrapError(err, ""))
+	}
+	return resp, err
+}
+
+func (auth *authGRPCServer) revertEmailChange(ctx context.Context, req *pb.RevertEmailChangeRequest) (*pb.RevertEmailChangeResponse, error) {
+	ok, err := auth.accountCase.RevertEmailChange(ctx, req.RevertToken)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevertEmailChangeResponse{
+		Ok: ok,
+	}, err
+}
+
+func (auth *authGRPCServer) contextWithMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, "method", method)
+}
+
+func (auth *authGRPCServer) getMethodFromContext(ctx context.Context) (string, error) {
+	methodName, ok := grpc.Method(ctx)
+	if !ok {
+		return "", errs.ErrBrokenContext
+	}
+	return fmt.Sprintf(deliveryMethodTemplate, methodName), nil
+}
+
+func (auth *authGRPCServer) getClientFromContext(ctx context.Context) *sessionModels.ClientInfo {
+	client := &sessionModels.ClientInfo{}
+
+	p, ok := peer.FromContext(ctx)
+	if ok && p.Addr != nil {
+		client.IP = p.Addr.String()
+		host, _, err := net.SplitHostPort(client.IP)
+		if err == nil {
+			client.IP = host
+		}
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		userAgent := md.Get(userAgentHeader)
+		if len(userAgent) > 0 {
+			client.UserAgent = userAgent[0]
+		}
+	}
+	return client
+}
+
+func (auth *authGRPCServer) grpcError(err error) error {
+	st := status.New(auth.mapStatusCode(err), err.Error())
+
+	var policyErr *errs.PolicyError
+	if errors.As(err, &policyErr) {
+		details := &errdetails.BadRequest{}
+		for _, v := range policyErr.Violations {
+			details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "password",
+				Description: fmt.Sprintf("%s: %s", v.Rule, v.Description),
+			})
+		}
+
+		withDetails, detailsErr := st.WithDetails(details)
+		if detailsErr == nil {
+			st = withDetails
+		}
+	}
+	return st.Err()
+}
+
+func (auth *authGRPCServer) wrapError(err error, email string) error {
+	return &errs.DeliveryError{
+		Email: email,
+		Err:   err,
+	}
+}
+
+func (auth *authGRPCServer) mapStatusCode(err error) codes.Code {
+	var policyErr *errs.PolicyError
+	if errors.As(err, &policyErr) {
+		return codes.InvalidArgument
+	}
+
+	// usecases call each other, so the error that tells what went wrong can
+	// sit below several layers of wrapping
+	for _, m := range statusCodes {
+		if errors.Is(err, m.err) {
+			return m.code
+		}
+	}
+
+	var repErr *errs.RepositoryError
+	var serviceErr *errs.ServiceError
+	var caseErr *errs.UsecaseError
+	if errors.As(err, &repErr) || errors.As(err, &serviceErr) || errors.As(err, &caseErr) {
+		return codes.Internal
 	}
 	return codes.Unknown
 }
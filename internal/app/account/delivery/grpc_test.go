@@ -0,0 +1,83 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+)
+
+func TestMapStatusCode(t *testing.T) {
+	auth := &authGRPCServer{}
+
+	// how errors reach the delivery: a usecase calling another one, which
+	// failed on its own or in the repository
+	nested := func(err error) error {
+		return &errs.DeliveryError{Email: "user@example.com", Err: &errs.UsecaseError{
+			Method: "ChangePassword/usecase",
+			Err:    &errs.UsecaseError{Method: "ParseAccessToken/usecase", Err: err},
+		}}
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"not found", errs.ErrNotFound, codes.NotFound},
+		{"repository not found", &errs.RepositoryError{Impl: "mongoDB", Err: errs.ErrNotFound}, codes.NotFound},
+		{"wrong password", nested(errs.ErrWrongPassword), codes.Unauthenticated},
+		{"nested token expired", nested(errs.ErrTokenExpired), codes.Unauthenticated},
+		{"nested session revoked", nested(errs.ErrSessionRevoked), codes.Unauthenticated},
+		{"email taken", nested(errs.ErrEmailTaken), codes.AlreadyExists},
+		{"invalid email", nested(errs.ErrInvalidEmail), codes.InvalidArgument},
+		{"fmt wrapped", nested(fmt.Errorf("%w: bad params", errs.ErrInvalidPasswordHash)), codes.InvalidArgument},
+		{"policy", nested(&errs.PolicyError{Violations: []errs.PolicyViolation{{Rule: "length"}}}), codes.InvalidArgument},
+		{"rate limited", nested(errs.ErrTooManyRequests), codes.ResourceExhausted},
+		{"pending", nested(errs.ErrAccountPending), codes.FailedPrecondition},
+		{"deleted", nested(errs.ErrAccountDeleted), codes.Aborted},
+		{"suspended", nested(errs.ErrAccountSuspended), codes.PermissionDenied},
+		{"locked", nested(errs.ErrAccountLocked), codes.Unavailable},
+		{"repository failure", nested(&errs.RepositoryError{Impl: "mongoDB", Err: errors.New("connection refused")}), codes.Internal},
+		{"service failure", nested(&errs.ServiceError{Method: "GetKV/redis", Err: errors.New("timeout")}), codes.Internal},
+		{"usecase failure", nested(errors.New("boom")), codes.Internal},
+		{"unwrapped", errors.New("boom"), codes.Unknown},
+	}
+
+	for _, tt := range tests {
+		if got := auth.mapStatusCode(tt.err); got != tt.want {
+			t.Errorf("%s: mapStatusCode(%v) = %v, want %v", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestMapStatusCodeAccountStates(t *testing.T) {
+	auth := &authGRPCServer{}
+
+	states := []error{
+		errs.ErrAccountPending,
+		errs.ErrAccountSuspended,
+		errs.ErrAccountLocked,
+		errs.ErrAccountDeleted,
+	}
+
+	seen := make(map[codes.Code]error, len(states))
+	for _, state := range states {
+		code := auth.mapStatusCode(&errs.UsecaseError{Method: "Login/usecase", Err: state})
+		if other, ok := seen[code]; ok {
+			t.Errorf("mapStatusCode(%v) = %v, same as for %v", state, code, other)
+		}
+		seen[code] = state
+	}
+
+	// nor may a state look like a failure the client handles otherwise
+	for _, err := range []error{errs.ErrNotFound, errs.ErrInvalidCredentials, errs.ErrTooManyRequests} {
+		code := auth.mapStatusCode(err)
+		if state, ok := seen[code]; ok {
+			t.Errorf("mapStatusCode(%v) = %v, same as for %v", err, code, state)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package account
+
+import (
+	"net/mail"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/barugoo/oscillo-auth/internal/app/errors"
+)
+
+// providerRule describes how a mail provider maps address variants to one
+// mailbox.
+type providerRule struct {
+	domain     string
+	ignoreDots bool
+	tagSep     string
+}
+
+var providerRules = map[string]providerRule{
+	"gmail.com":      {domain: "gmail.com", ignoreDots: true, tagSep: "+"},
+	"googlemail.com": {domain: "gmail.com", ignoreDots: true, tagSep: "+"},
+	"outlook.com":    {domain: "outlook.com", tagSep: "+"},
+	"hotmail.com":    {domain: "hotmail.com", tagSep: "+"},
+	"live.com":       {domain: "live.com", tagSep: "+"},
+	"icloud.com":     {domain: "icloud.com", tagSep: "+"},
+	"fastmail.com":   {domain: "fastmail.com", tagSep: "+"},
+	"protonmail.com": {domain: "protonmail.com", tagSep: "+"},
+	"yandex.ru":      {domain: "yandex.ru", tagSep: "+"},
+}
+
+// emailFormVersion changes whenever NormalizeEmail starts returning other
+// emails for addresses it accepted before.
+const emailFormVersion = "v1"
+
+// EmailForm names the form NormalizeEmail brings emails to with the given
+// providerSpecific setting.
+func EmailForm(providerSpecific bool) string {
+	if providerSpecific {
+		return emailFormVersion + "-provider"
+	}
+	return emailFormVersion
+}
+
+// NormalizeEmail returns the canonical form accounts are stored and looked up
+// by: trimmed, NFC normalized and case folded. With providerSpecific set, the
+// variants known providers deliver to the same mailbox, like dots and +tags
+// in gmail addresses, are collapsed too.
+//
+// Only a bare address is accepted, no display name, comments or whitespace,
+// since it ends up in mail headers.
+func NormalizeEmail(email string, providerSpecific bool) (string, error) {
+	// a trailing dot makes the domain fully qualified, but mail.ParseAddress
+	// doesn't take it
+	email = strings.TrimSuffix(norm.NFC.String(strings.TrimSpace(email)), ".")
+
+	if strings.ContainsAny(email, " \t\r\n") {
+		return "", errors.ErrInvalidEmail
+	}
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Name != "" || addr.Address != email {
+		return "", errors.ErrInvalidEmail
+	}
+
+	i := strings.LastIndex(email, "@")
+	if i <= 0 || i == len(email)-1 {
+		return "", errors.ErrInvalidEmail
+	}
+
+	// full folding would turn IDN domains like ß.de into other domains, so
+	// they're only lowercased
+	local := norm.NFC.String(cases.Fold().String(email[:i]))
+	domain := strings.ToLower(email[i+1:])
+
+	if providerSpecific {
+		if rule, ok := providerRules[domain]; ok {
+			local, domain = rule.apply(local)
+			if local == "" {
+				return "", errors.ErrInvalidEmail
+			}
+		}
+	}
+	return local + "@" + domain, nil
+}
+
+func (r providerRule) apply(local string) (string, string) {
+	if j := strings.Index(local, r.tagSep); j >= 0 {
+		local = local[:j]
+	}
+	if r.ignoreDots {
+		local = strings.Replace(local, ".", "", -1)
+	}
+	return local, r.domain
+}
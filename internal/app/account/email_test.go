@@ -0,0 +1,57 @@
+package account
+
+import (
+	"testing"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		email            string
+		providerSpecific bool
+		want             string
+		wantErr          bool
+	}{
+		{email: "user@example.com", want: "user@example.com"},
+		{email: "  User@Example.COM ", want: "user@example.com"},
+		{email: "user@example.com.", want: "user@example.com"},
+		{email: "Straße@ß.de", want: "strasse@ß.de"},
+		{email: "üser@example.com", want: "üser@example.com"},
+		{email: "a@[192.0.2.1]", want: "a@[192.0.2.1]"},
+
+		{email: "First.Last+tag@GMail.com", want: "first.last+tag@gmail.com"},
+		{email: "First.Last+tag@GMail.com", providerSpecific: true, want: "firstlast@gmail.com"},
+		{email: "first.last@googlemail.com", providerSpecific: true, want: "firstlast@gmail.com"},
+		{email: "first.last+tag@outlook.com", providerSpecific: true, want: "first.last@outlook.com"},
+		{email: "first.last+tag@example.com", providerSpecific: true, want: "first.last+tag@example.com"},
+		{email: "+tag@gmail.com", providerSpecific: true, wantErr: true},
+
+		{email: "", wantErr: true},
+		{email: "user", wantErr: true},
+		{email: "user@", wantErr: true},
+		{email: "@example.com", wantErr: true},
+		{email: "us er@example.com", wantErr: true},
+		{email: "user@example.com\r\nBcc: other@example.com", wantErr: true},
+		{email: "user@exa\tmple.com", wantErr: true},
+		{email: "User <user@example.com>", wantErr: true},
+		{email: "<user@example.com>", wantErr: true},
+		{email: "user@example.com (comment)", wantErr: true},
+		{email: "a@b@example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizeEmail(tt.email, tt.providerSpecific)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("NormalizeEmail(%q, %v) = %q, want error", tt.email, tt.providerSpecific, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("NormalizeEmail(%q, %v) error: %v", tt.email, tt.providerSpecific, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizeEmail(%q, %v) = %q, want %q", tt.email, tt.providerSpecific, got, tt.want)
+		}
+	}
+}
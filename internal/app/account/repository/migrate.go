@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/account"
+)
+
+const (
+	actorMigration = "migration"
+
+	// duplicateEmailSuffix is appended to the email of accounts that normalize
+	// to an address another account keeps. The result isn't a valid address,
+	// so nobody can sign in to them, but they're kept for an admin to sort
+	// out.
+	duplicateEmailSuffix = "#duplicate-"
+
+	// normalizing emails scans the whole collection, so only one instance
+	// does it, once for every email form. The others wait for it to finish,
+	// their unique index can't be built before.
+	normalizeEmailsLockKey         = "migration_lock:normalize_emails"
+	normalizeEmailsDoneKeyTemplate = "migration_done:normalize_emails:%s"
+	normalizeEmailsLockTTL         = 10 * time.Minute
+	normalizeEmailsPollInterval    = time.Second
+)
+
+func (h *accountRepository) MigrateAccounts(ctx context.Context) error {
+	span := h.service.StartSpan(ctx, "MigrateAccounts")
+	defer span.Finish()
+
+	err := h.migrateAccounts()
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return err
+}
+
+// migrateAccounts brings documents written by older versions up to date. Every
+// step only matches documents it hasn't migrated yet, so it's safe to run on
+// every start.
+func (h *accountRepository) migrateAccounts() error {
+	err := h.migrateObjectIDs()
+	if err != nil {
+		return err
+	}
+	return h.migrateIsActive(time.Now().UTC())
+}
+
+// migrateObjectIDs moves accounts inserted with a generated ObjectID _id to a
+// string _id, which is what the ID field decodes from. The old id field is
+// kept as the new _id if it was set, so IDs already handed out stay valid.
+// _id can't be changed in place, so each account is reinserted and the old
+// document deleted in one transaction.
+func (h *accountRepository) migrateObjectIDs() error {
+	cursor, err := h.collection.Find(context.TODO(), bson.D{{Key: "_id", Value: bson.D{{Key: "$type", Value: "objectId"}}}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(context.TODO())
+
+	session, err := h.collection.Database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(context.TODO())
+
+	for cursor.Next(context.TODO()) {
+		var doc bson.D
+		err = cursor.Decode(&doc)
+		if err != nil {
+			return err
+		}
+
+		oldID, newID, migrated := migratedObjectIDDoc(doc)
+		_, err = session.WithTransaction(context.TODO(), func(sc mongo.SessionContext) (interface{}, error) {
+			_, err := h.collection.InsertOne(sc, migrated)
+			if err != nil {
+				return nil, err
+			}
+			return h.collection.DeleteOne(sc, bson.D{{Key: "_id", Value: oldID}})
+		})
+		if err != nil {
+			return fmt.Errorf("migrate account %s to _id %q: %v", oldID.Hex(), newID, err)
+		}
+	}
+	return cursor.Err()
+}
+
+// migratedObjectIDDoc returns a copy of doc with a string _id and without
+// the id field.
+func migratedObjectIDDoc(doc bson.D) (primitive.ObjectID, string, bson.D) {
+	var oldID primitive.ObjectID
+	var newID string
+	migrated := make(bson.D, 0, len(doc))
+	for _, elem := range doc {
+		switch elem.Key {
+		case "_id":
+			oldID, _ = elem.Value.(primitive.ObjectID)
+		case "id":
+			newID, _ = elem.Value.(string)
+		default:
+			migrated = append(migrated, elem)
+		}
+	}
+	if newID == "" {
+		newID = oldID.Hex()
+	}
+	return oldID, newID, append(bson.D{{Key: "_id", Value: newID}}, migrated...)
+}
+
+// migrateIsActive replaces the isactive flag accounts had before statuses.
+// An active account had its email confirmed, so it's marked verified too.
+func (h *accountRepository) migrateIsActive(now time.Time) error {
+	steps := []struct {
+		filter bson.D
+		status models.Status
+	}{
+		{bson.D{{Key: "status", Value: bson.D{{Key: "$exists", Value: false}}}, {Key: "isactive", Value: true}}, models.StatusActive},
+		{bson.D{{Key: "status", Value: bson.D{{Key: "$exists", Value: false}}}}, models.StatusPending},
+	}
+
+	for _, step := range steps {
+		_, err := h.collection.UpdateMany(context.TODO(), step.filter, bson.D{
+			{Key: "$set", Value: bson.D{
+				{Key: "status", Value: step.status},
+				{Key: "emailverified", Value: step.status == models.StatusActive},
+				{Key: "statusactor", Value: actorMigration},
+				{Key: "statuschangedat", Value: now},
+			}},
+			{Key: "$unset", Value: bson.D{{Key: "isactive", Value: ""}}},
+			{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *accountRepository) NormalizeEmails(ctx context.Context, form string, normalize func(email string) (string, error)) error {
+	span := h.service.StartSpan(ctx, "NormalizeEmails")
+	defer span.Finish()
+
+	err := h.normalizeEmailsOnce(ctx, form, normalize)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return err
+}
+
+// normalizeEmailsOnce runs normalizeEmails unless it already ran for form,
+// and waits while another instance runs it.
+func (h *accountRepository) normalizeEmailsOnce(ctx context.Context, form string, normalize func(email string) (string, error)) error {
+	doneKey := fmt.Sprintf(normalizeEmailsDoneKeyTemplate, form)
+	owner := primitive.NewObjectID().Hex()
+
+	for {
+		_, err := h.service.GetKV(ctx, doneKey)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, errs.ErrNotFound) {
+			return err
+		}
+
+		ok, err := h.service.SetKVNX(ctx, normalizeEmailsLockKey, owner, normalizeEmailsLockTTL)
+		if err != nil {
+			return err
+		}
+		if ok {
+			break
+		}
+		time.Sleep(normalizeEmailsPollInterval)
+	}
+	defer h.service.DelKVIfEqual(ctx, normalizeEmailsLockKey, owner)
+
+	err := h.normalizeEmails(normalize)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.service.SetKV(ctx, doneKey, time.Now().UTC().Format(time.RFC3339))
+	return err
+}
+
+type emailOwner struct {
+	ID            string    `bson:"_id"`
+	Email         string    `bson:"email"`
+	EmailVerified bool      `bson:"emailverified"`
+	CreatedAt     time.Time `bson:"createdat"`
+}
+
+// normalizeEmails rewrites every email that isn't in normalized form yet. If
+// several accounts normalize to the same email, the verified one created
+// first keeps it, the others get duplicateEmailSuffix. Emails normalize
+// rejects are left as they are.
+func (h *accountRepository) normalizeEmails(normalize func(email string) (string, error)) error {
+	projection := bson.D{
+		{Key: "email", Value: 1},
+		{Key: "emailverified", Value: 1},
+		{Key: "createdat", Value: 1},
+	}
+	cursor, err := h.collection.Find(context.TODO(), bson.D{}, options.Find().SetProjection(projection))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(context.TODO())
+
+	owners := make(map[string][]*emailOwner)
+	for cursor.Next(context.TODO()) {
+		var owner emailOwner
+		err = cursor.Decode(&owner)
+		if err != nil {
+			return err
+		}
+
+		email, err := normalize(owner.Email)
+		if err != nil {
+			log.Printf("account %s: can't normalize email: %v", owner.ID, err)
+			continue
+		}
+		owners[email] = append(owners[email], &owner)
+	}
+	if err = cursor.Err(); err != nil {
+		return err
+	}
+
+	// losers are renamed before the winners take the normalized email, one of
+	// them may hold it now
+	var renames []*emailOwner
+	for email, group := range owners {
+		sort.Slice(group, func(i, j int) bool {
+			a, b := group[i], group[j]
+			if a.EmailVerified != b.EmailVerified {
+				return a.EmailVerified
+			}
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.Before(b.CreatedAt)
+			}
+			return a.ID < b.ID
+		})
+
+		for _, loser := range group[1:] {
+			renamed := loser.Email + duplicateEmailSuffix + loser.ID
+			log.Printf("account %s: email normalizes to the one of account %s, marked as duplicate", loser.ID, group[0].ID)
+			err = h.setEmail(loser.ID, renamed)
+			if err != nil {
+				return err
+			}
+		}
+
+		if group[0].Email != email {
+			renames = append(renames, &emailOwner{ID: group[0].ID, Email: email})
+		}
+	}
+
+	for _, winner := range renames {
+		err = h.setEmail(winner.ID, winner.Email)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *accountRepository) setEmail(id, email string) error {
+	_, err := h.collection.UpdateOne(context.TODO(),
+		bson.D{{Key: "_id", Value: id}},
+		bson.D{
+			{Key: "$set", Value: bson.D{{Key: "email", Value: email}}},
+			{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
+		},
+	)
+	return err
+}
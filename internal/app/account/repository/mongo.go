@@ -3,32 +3,65 @@ package repository
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/barugoo/oscillo-auth/internal/app/errors"
 	"github.com/barugoo/oscillo-auth/internal/app/service"
 
 	models "github.com/barugoo/oscillo-auth/internal/app/account"
+
+	eventModels "github.com/barugoo/oscillo-auth/internal/app/event"
 )
 
 const (
 	mongoDB = "mongoDB"
+
+	emailIndex = "email_unique"
+
+	duplicateKeyCode = 11000
 )
 
 type accountRepository struct {
 	service    service.AuthService
 	collection *mongo.Collection
+	outbox     *mongo.Collection
 }
 
-func NewAccountRepository(service service.AuthService, collection *mongo.Collection) AccountRepository {
+func NewAccountRepository(service service.AuthService, collection, outbox *mongo.Collection) AccountRepository {
 	return &accountRepository{
 		service:    service,
 		collection: collection,
+		outbox:     outbox,
 	}
 }
 
+func (h *accountRepository) EnsureIndexes(ctx context.Context) error {
+	span := h.service.StartSpan(ctx, "EnsureIndexes")
+	defer span.Finish()
+
+	err := h.ensureIndexes()
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return err
+}
+
+// ensureIndexes is safe to call on every start, creating an index that
+// already exists with the same options is a no-op.
+func (h *accountRepository) ensureIndexes() error {
+	_, err := h.collection.Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "email", Value: 1}},
+		Options: options.Index().SetName(emailIndex).SetUnique(true),
+	})
+	return err
+}
+
 func (h *accountRepository) GetAccountByEmail(ctx context.Context, email string) (*models.Account, error) {
 	span := h.service.StartSpan(ctx, "GetAccountByEmail")
 	defer span.Finish()
@@ -42,82 +75,328 @@ func (h *accountRepository) GetAccountByEmail(ctx context.Context, email string)
 
 func (h *accountRepository) getAccountByEmail(email string) (*models.Account, error) {
 	var account *models.Account
-	err := h.collection.FindOne(context.TODO(), bson.D{{"email", email}}).Decode(&account)
+	err := h.collection.FindOne(context.TODO(), bson.D{{Key: "email", Value: email}}).Decode(&account)
 	if err != nil {
 		return nil, err
 	}
 	return account, nil
 }
 
-func (h *accountRepository) CreateAccount(ctx context.Context, account *models.Account) (*models.Account, error) {
-	span := h.service.StartSpan(ctx, "CreateAccount")
+func (h *accountRepository) GetAccountByID(ctx context.Context, id string) (*models.Account, error) {
+	span := h.service.StartSpan(ctx, "GetAccountByID")
 	defer span.Finish()
 
-	account, err := h.createAccount(account)
+	account, err := h.getAccountByID(id)
 	if err != nil {
 		err = h.wrapError(err)
 	}
 	return account, err
 }
 
-func (h *accountRepository) createAccount(account *models.Account) (*models.Account, error) {
-	result, err := h.collection.InsertOne(context.TODO(), account)
+func (h *accountRepository) getAccountByID(id string) (*models.Account, error) {
+	var account *models.Account
+	err := h.collection.FindOne(context.TODO(), bson.D{{Key: "_id", Value: id}}).Decode(&account)
 	if err != nil {
 		return nil, err
 	}
+	return account, nil
+}
+
+func (h *accountRepository) GetAccountsToPurge(ctx context.Context, now time.Time, limit int) ([]*models.Account, error) {
+	span := h.service.StartSpan(ctx, "GetAccountsToPurge")
+	defer span.Finish()
+
+	accounts, err := h.getAccountsToPurge(now, limit)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return accounts, err
+}
+
+func (h *accountRepository) getAccountsToPurge(now time.Time, limit int) ([]*models.Account, error) {
+	// accounts that aren't deleted have a zero purgeat
+	filter := bson.D{{Key: "purgeat", Value: bson.D{
+		{Key: "$gt", Value: time.Time{}},
+		{Key: "$lte", Value: now},
+	}}}
+	opts := options.Find().SetLimit(int64(limit))
+
+	cursor, err := h.collection.Find(context.TODO(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.TODO())
+
+	var accounts []*models.Account
+	err = cursor.All(context.TODO(), &accounts)
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func (h *accountRepository) ListAccounts(ctx context.Context, filter *models.AccountFilter, after string, limit int) ([]*models.Account, error) {
+	span := h.service.StartSpan(ctx, "ListAccounts")
+	defer span.Finish()
+
+	accounts, err := h.listAccounts(filter, after, limit)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return accounts, err
+}
+
+func (h *accountRepository) listAccounts(filter *models.AccountFilter, after string, limit int) ([]*models.Account, error) {
+	query := accountFilterQuery(filter, time.Now())
+	if after != "" {
+		query = append(query, bson.E{Key: "_id", Value: bson.D{{Key: "$gt", Value: after}}})
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(limit))
+
+	cursor, err := h.collection.Find(context.TODO(), query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.TODO())
+
+	var accounts []*models.Account
+	err = cursor.All(context.TODO(), &accounts)
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// accountFilterQuery matches statuses the way Account.CurrentStatus reports
+// them, so suspensions and locks that ran out count as lifted.
+func accountFilterQuery(filter *models.AccountFilter, now time.Time) bson.D {
+	query := bson.D{}
+	if filter == nil {
+		return query
+	}
+
+	expired := bson.D{
+		{Key: "status", Value: bson.D{{Key: "$in", Value: bson.A{models.StatusSuspended, models.StatusLocked}}}},
+		{Key: "statusexpiresat", Value: bson.D{{Key: "$gt", Value: time.Time{}}, {Key: "$lte", Value: now}}},
+	}
+
+	switch filter.Status {
+	case "":
+	case models.StatusActive:
+		query = append(query, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "status", Value: models.StatusActive}},
+			append(expired, bson.E{Key: "emailverified", Value: true}),
+		}})
+	case models.StatusPending:
+		query = append(query, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "status", Value: bson.D{{Key: "$in", Value: bson.A{models.StatusPending, ""}}}}},
+			append(expired, bson.E{Key: "emailverified", Value: false}),
+		}})
+	case models.StatusSuspended, models.StatusLocked:
+		query = append(query,
+			bson.E{Key: "status", Value: filter.Status},
+			bson.E{Key: "$or", Value: bson.A{
+				bson.D{{Key: "statusexpiresat", Value: time.Time{}}},
+				bson.D{{Key: "statusexpiresat", Value: bson.D{{Key: "$gt", Value: now}}}},
+			}},
+		)
+	default:
+		query = append(query, bson.E{Key: "status", Value: filter.Status})
+	}
+
+	if filter.Has2FA != nil {
+		// the secret is empty rather than missing once 2FA was removed
+		op := "$in"
+		if *filter.Has2FA {
+			op = "$nin"
+		}
+		query = append(query, bson.E{Key: "secret2fa", Value: bson.D{{Key: op, Value: bson.A{"", nil}}}})
+	}
+
+	created := bson.D{}
+	if !filter.CreatedAfter.IsZero() {
+		created = append(created, bson.E{Key: "$gte", Value: filter.CreatedAfter})
+	}
+	if !filter.CreatedBefore.IsZero() {
+		created = append(created, bson.E{Key: "$lt", Value: filter.CreatedBefore})
+	}
+	if len(created) > 0 {
+		query = append(query, bson.E{Key: "createdat", Value: created})
+	}
+
+	if filter.EmailPrefix != "" {
+		query = append(query, bson.E{Key: "email", Value: bson.D{{Key: "$regex", Value: "^" + regexp.QuoteMeta(filter.EmailPrefix)}}})
+	}
+	return query
+}
+
+func (h *accountRepository) CreateAccount(ctx context.Context, account *models.Account, events ...*eventModels.Event) (*models.Account, error) {
+	span := h.service.StartSpan(ctx, "CreateAccount")
+	defer span.Finish()
+
+	// the ID is assigned outside the transaction, so a retried transaction
+	// inserts the same account, and events created before it was known get it
+	// as well.
+	if account.ID == "" {
+		account.ID = primitive.NewObjectID().Hex()
+	}
+	for _, event := range events {
+		if event.AccountID == "" {
+			event.AccountID = account.ID
+		}
+	}
 
 	var acc *models.Account
+	err := h.withEvents(events, func(ctx context.Context) error {
+		var err error
+		acc, err = h.createAccount(ctx, account)
+		return err
+	})
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return acc, err
+}
 
-	err = h.collection.FindOne(context.TODO(), bson.D{{"id", result.InsertedID}}).Decode(&acc)
+func (h *accountRepository) createAccount(ctx context.Context, account *models.Account) (*models.Account, error) {
+	_, err := h.collection.InsertOne(ctx, account)
 	if err != nil {
 		return nil, err
 	}
-	return acc, nil
+	return account, nil
 }
 
-func (h *accountRepository) DeleteAccount(ctx context.Context, account *models.Account) (bool, error) {
+func (h *accountRepository) DeleteAccount(ctx context.Context, account *models.Account, events ...*eventModels.Event) (bool, error) {
 	span := h.service.StartSpan(ctx, "DeleteAccount")
 	defer span.Finish()
 
-	ok, err := h.deleteAccount(account)
+	var ok bool
+	err := h.withEvents(events, func(ctx context.Context) error {
+		var err error
+		ok, err = h.deleteAccount(ctx, account)
+		return err
+	})
 	if err != nil {
 		err = h.wrapError(err)
 	}
 	return ok, err
 }
 
-func (h *accountRepository) deleteAccount(account *models.Account) (bool, error) {
-	_, err := h.collection.DeleteOne(context.TODO(), bson.D{{"id", account.ID}})
+func (h *accountRepository) deleteAccount(ctx context.Context, account *models.Account) (bool, error) {
+	_, err := h.collection.DeleteOne(ctx, bson.D{{Key: "_id", Value: account.ID}})
 	if err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
-func (h *accountRepository) UpdateAccount(ctx context.Context, account *models.Account) (*models.Account, error) {
+func (h *accountRepository) UpdateAccount(ctx context.Context, account *models.Account, events ...*eventModels.Event) (*models.Account, error) {
 	span := h.service.StartSpan(ctx, "UpdateAccount")
 	defer span.Finish()
 
-	account, err := h.updateAccount(account)
+	// read outside the transaction, a retried transaction has to expect the
+	// same version again
+	version := account.Version
+
+	var acc *models.Account
+	err := h.withEvents(events, func(ctx context.Context) error {
+		var err error
+		acc, err = h.updateAccount(ctx, account, version)
+		return err
+	})
 	if err != nil {
-		err = h.wrapError(err)
+		return nil, h.wrapError(err)
 	}
-	return account, err
+	account.Version = acc.Version
+	return acc, nil
 }
 
-func (h *accountRepository) updateAccount(account *models.Account) (*models.Account, error) {
-	_, err := h.collection.UpdateOne(context.TODO(), bson.D{{"id", account.ID}}, account)
+// updateAccount replaces the document only if it's still at version, so a
+// change made since the account was read, e.g. a suspension landing while a
+// password was being hashed, is never overwritten. The caller gets
+// ErrNotFound and has to read the account again.
+func (h *accountRepository) updateAccount(ctx context.Context, account *models.Account, version int64) (*models.Account, error) {
+	filter := bson.D{{Key: "_id", Value: account.ID}, {Key: "version", Value: version}}
+	if version == 0 {
+		// accounts stored before versioning don't have the field yet
+		filter[1].Value = bson.D{{Key: "$in", Value: bson.A{0, nil}}}
+	}
+
+	updated := *account
+	updated.Version = version + 1
+
+	result, err := h.collection.ReplaceOne(ctx, filter, &updated)
 	if err != nil {
 		return nil, err
 	}
-	return account, nil
+	if result.MatchedCount == 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+	return &updated, nil
+}
+
+func (h *accountRepository) ReplacePasswordHash(ctx context.Context, id, current, hash string) (bool, error) {
+	span := h.service.StartSpan(ctx, "ReplacePasswordHash")
+	defer span.Finish()
+
+	ok, err := h.replacePasswordHash(id, current, hash)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return ok, err
+}
+
+func (h *accountRepository) replacePasswordHash(id, current, hash string) (bool, error) {
+	result, err := h.collection.UpdateOne(context.TODO(),
+		bson.D{{Key: "_id", Value: id}, {Key: "passwordhash", Value: current}},
+		bson.D{
+			{Key: "$set", Value: bson.D{{Key: "passwordhash", Value: hash}}},
+			{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+// withEvents runs fn and stores events in one transaction, so an account
+// change is never committed without its outbox events or the other way round.
+// Transactions need MongoDB to run as a replica set.
+func (h *accountRepository) withEvents(events []*eventModels.Event, fn func(ctx context.Context) error) error {
+	if len(events) == 0 {
+		return fn(context.TODO())
+	}
+
+	session, err := h.collection.Database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(context.TODO())
+
+	_, err = session.WithTransaction(context.TODO(), func(sc mongo.SessionContext) (interface{}, error) {
+		err := fn(sc)
+		if err != nil {
+			return nil, err
+		}
+
+		docs := make([]interface{}, 0, len(events))
+		for _, event := range events {
+			docs = append(docs, event)
+		}
+		_, err = h.outbox.InsertMany(sc, docs)
+		return nil, err
+	})
+	return err
 }
 
 func (h *accountRepository) wrapError(err error) error {
 
-	switch err {
-	case mongo.ErrNoDocuments:
+	switch {
+	case err == mongo.ErrNoDocuments:
 		err = errors.ErrNotFound
+	case isDuplicateKey(err):
+		err = errors.ErrAlreadyExists
 	default:
 		err = fmt.Errorf("%v", err)
 	}
@@ -126,3 +405,17 @@ func (h *accountRepository) wrapError(err error) error {
 		Err:  err,
 	}
 }
+
+func isDuplicateKey(err error) bool {
+	switch e := err.(type) {
+	case mongo.WriteException:
+		for _, we := range e.WriteErrors {
+			if we.Code == duplicateKeyCode {
+				return true
+			}
+		}
+	case mongo.CommandError:
+		return e.Code == duplicateKeyCode
+	}
+	return false
+}
@@ -2,13 +2,34 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	models "github.com/barugoo/oscillo-auth/internal/app/account"
+
+	eventModels "github.com/barugoo/oscillo-auth/internal/app/event"
 )
 
+// Mutating methods take the outbox events describing the change. They're
+// stored in the same transaction as the account itself.
 type AccountRepository interface {
+	// MigrateAccounts upgrades documents stored by older versions. It runs
+	// before EnsureIndexes.
+	MigrateAccounts(ctx context.Context) error
+	// NormalizeEmails brings stored emails to the form normalize returns and
+	// resolves the duplicates that turns up. It runs once for every form, on
+	// one instance, before EnsureIndexes.
+	NormalizeEmails(ctx context.Context, form string, normalize func(email string) (string, error)) error
+	EnsureIndexes(ctx context.Context) error
 	GetAccountByEmail(ctx context.Context, email string) (*models.Account, error)
-	CreateAccount(ctx context.Context, account *models.Account) (*models.Account, error)
-	DeleteAccount(ctx context.Context, account *models.Account) (bool, error)
-	UpdateAccount(ctx context.Context, account *models.Account) (*models.Account, error)
+	GetAccountByID(ctx context.Context, id string) (*models.Account, error)
+	GetAccountsToPurge(ctx context.Context, now time.Time, limit int) ([]*models.Account, error)
+	// ListAccounts returns up to limit accounts matching filter, ordered by ID
+	// and starting after the account with the ID after.
+	ListAccounts(ctx context.Context, filter *models.AccountFilter, after string, limit int) ([]*models.Account, error)
+	CreateAccount(ctx context.Context, account *models.Account, events ...*eventModels.Event) (*models.Account, error)
+	DeleteAccount(ctx context.Context, account *models.Account, events ...*eventModels.Event) (bool, error)
+	UpdateAccount(ctx context.Context, account *models.Account, events ...*eventModels.Event) (*models.Account, error)
+	// ReplacePasswordHash sets the hash only if the stored one is still
+	// current, so it never undoes a password change that happened meanwhile.
+	ReplacePasswordHash(ctx context.Context, id, current, hash string) (bool, error)
 }
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/account"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+func (uc *accountUsecase) GetAccount(ctx context.Context, id, email string) (*models.Account, error) {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	account, err := uc.getAccount(ctx, id, email)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return account, err
+}
+
+// getAccount looks the account up by ID if given and by email otherwise.
+func (uc *accountUsecase) getAccount(ctx context.Context, id, email string) (*models.Account, error) {
+	if id != "" {
+		return uc.repository.GetAccountByID(ctx, id)
+	}
+	if email == "" {
+		return nil, errs.ErrAccountNotSpecified
+	}
+
+	email, err := uc.normalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repository.GetAccountByEmail(ctx, email)
+}
+
+func (uc *accountUsecase) ListAccounts(ctx context.Context, filter *models.AccountFilter, cursor string, limit int) ([]*models.Account, string, error) {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	accounts, next, err := uc.listAccounts(ctx, filter, cursor, limit)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return accounts, next, err
+}
+
+// listAccounts pages by account ID. The returned cursor continues after the
+// last account of the page and is empty once there are no more accounts.
+func (uc *accountUsecase) listAccounts(ctx context.Context, filter *models.AccountFilter, cursor string, limit int) ([]*models.Account, string, error) {
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	// emails are stored case folded, the prefix has to match that
+	if filter != nil && filter.EmailPrefix != "" {
+		f := *filter
+		f.EmailPrefix = strings.ToLower(strings.TrimSpace(f.EmailPrefix))
+		filter = &f
+	}
+
+	// one more than asked tells whether another page follows
+	accounts, err := uc.repository.ListAccounts(ctx, filter, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(accounts) > limit {
+		accounts = accounts[:limit]
+		next = accounts[limit-1].ID
+	}
+	return accounts, next, nil
+}
@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/account"
+
+	eventModels "github.com/barugoo/oscillo-auth/internal/app/event"
+)
+
+func (uc *accountUsecase) DeleteAccount(ctx context.Context, accessToken, password, code string) (time.Time, error) {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	purgeAt, err := uc.deleteAccount(ctx, accessToken, password, code)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return purgeAt, err
+}
+
+// deleteAccount only marks the account deleted and signs it out everywhere.
+// The document stays, and keeps its email taken, until the purge job removes
+// it after the grace period.
+func (uc *accountUsecase) deleteAccount(ctx context.Context, accessToken, password, code string) (time.Time, error) {
+	claims, err := uc.tokenCase.ParseAccessToken(ctx, accessToken)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	account, err := uc.repository.GetAccountByID(ctx, claims.Subject)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if account.IsDeleted() {
+		return time.Time{}, errs.ErrAccountDeleted
+	}
+
+	err = uc.reauthenticate(ctx, account, password, code)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now := time.Now().UTC()
+	account.DeletedAt = now
+	account.PurgeAt = now.Add(uc.config.AccountDeletionGracePeriod)
+	account.SetStatus(models.StatusDeleted, "deletion requested", actorSelf, time.Time{})
+
+	event, err := uc.accountEvent(eventModels.TypeDeletionRequested, account)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	_, err = uc.repository.UpdateAccount(ctx, account, event)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	_, err = uc.sessionCase.RevokeAccountSessions(ctx, account.ID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	err = uc.tokenCase.RevokeAccountTokens(ctx, account.ID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return account.PurgeAt, nil
+}
+
+func (uc *accountUsecase) CancelDeletion(ctx context.Context, cred *models.Credentials, code string) (bool, error) {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	ok, err := uc.cancelDeletion(ctx, cred, code)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return ok, err
+}
+
+// cancelDeletion authenticates with credentials because deleting the account
+// revoked every session it had.
+func (uc *accountUsecase) cancelDeletion(ctx context.Context, cred *models.Credentials, code string) (bool, error) {
+	email, err := uc.normalizeEmail(cred.Email)
+	if err != nil {
+		return false, err
+	}
+	cred.Email = email
+
+	account, err := uc.repository.GetAccountByEmail(ctx, cred.Email)
+	if errors.Is(err, errs.ErrNotFound) {
+		uc.verifyDummyPassword(cred.Password)
+		return false, errs.ErrInvalidCredentials
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.reauthenticate(ctx, account, cred.Password, code)
+	if err != nil {
+		return false, err
+	}
+
+	if !account.IsDeleted() {
+		return false, errs.ErrDeletionNotPending
+	}
+	if !time.Now().Before(account.PurgeAt) {
+		return false, errs.ErrAccountDeleted
+	}
+
+	account.DeletedAt = time.Time{}
+	account.PurgeAt = time.Time{}
+	account.SetStatus(account.BaseStatus(), "", actorSelf, time.Time{})
+
+	event, err := uc.accountEvent(eventModels.TypeDeletionCancelled, account)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = uc.repository.UpdateAccount(ctx, account, event)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (uc *accountUsecase) PurgeDeletedAccounts(ctx context.Context) (int, error) {
+	// run by a background job, so there's no delivery method in ctx
+	methodName := fmt.Sprintf(usecaseMethodTemplate, "PurgeDeletedAccounts")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	n, err := uc.purgeDeletedAccounts(ctx)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return n, err
+}
+
+func (uc *accountUsecase) purgeDeletedAccounts(ctx context.Context) (int, error) {
+	accounts, err := uc.repository.GetAccountsToPurge(ctx, time.Now().UTC(), uc.config.AccountPurgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, account := range accounts {
+		err = uc.purgeAccount(ctx, account)
+		if err != nil {
+			// one broken account shouldn't hold back the rest of the batch
+			log.Println(err)
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
+
+// purgeAccount clears everything kept about the account in Redis before
+// removing the document, so a failed purge is simply retried on the next run.
+func (uc *accountUsecase) purgeAccount(ctx context.Context, account *models.Account) error {
+	_, err := uc.sessionCase.RevokeAccountSessions(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	// the pending 2FA secret is stored under the bare account ID, see generate2FA
+	keys := []string{account.ID, fmt.Sprintf(reauthAttemptsKeyTemplate, account.ID)}
+
+	// reset, verification and email change tokens are found through their
+	// per-account pointer. Revert links have none, they expire on their own
+	// and fail once the account is gone.
+	pointers := map[string]string{
+		fmt.Sprintf(resetAccountKeyTemplate, account.ID):        resetTokenKeyTemplate,
+		fmt.Sprintf(verificationAccountKeyTemplate, account.ID): verificationTokenKeyTemplate,
+		fmt.Sprintf(emailChangeAccountKeyTemplate, account.ID):  emailChangeTokenKeyTemplate,
+	}
+	for pointer, tokenTemplate := range pointers {
+		keys = append(keys, pointer)
+
+		tokenHash, err := uc.service.GetKV(ctx, pointer)
+		if errors.Is(err, errs.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		keys = append(keys, fmt.Sprintf(tokenTemplate, tokenHash))
+	}
+
+	_, err = uc.service.DelKV(ctx, keys...)
+	if err != nil {
+		return err
+	}
+
+	event, err := uc.accountEvent(eventModels.TypeAccountDeleted, account)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.repository.DeleteAccount(ctx, account, event)
+	return err
+}
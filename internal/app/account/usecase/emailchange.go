@@ -0,0 +1,330 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+	"github.com/barugoo/oscillo-auth/internal/app/mailer"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/account"
+
+	eventModels "github.com/barugoo/oscillo-auth/internal/app/event"
+)
+
+const (
+	emailChangeTokenKeyTemplate   = "email_change:%s"
+	emailChangeAccountKeyTemplate = "email_change_account:%s"
+	emailRevertTokenKeyTemplate   = "email_revert:%s"
+)
+
+// emailChange is stored behind both confirmation and revert tokens.
+type emailChange struct {
+	AccountID string `json:"account_id"`
+	OldEmail  string `json:"old_email"`
+	NewEmail  string `json:"new_email"`
+}
+
+func (uc *accountUsecase) RequestEmailChange(ctx context.Context, accessToken, newEmail, password, code string) error {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	err := uc.requestEmailChange(ctx, accessToken, newEmail, password, code)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return err
+}
+
+func (uc *accountUsecase) requestEmailChange(ctx context.Context, accessToken, newEmail, password, code string) error {
+	newEmail, err := uc.normalizeEmail(newEmail)
+	if err != nil {
+		return err
+	}
+
+	claims, err := uc.tokenCase.ParseAccessToken(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	account, err := uc.repository.GetAccountByID(ctx, claims.Subject)
+	if err != nil {
+		return err
+	}
+
+	err = uc.reauthenticate(ctx, account, password, code)
+	if err != nil {
+		return err
+	}
+
+	err = uc.checkEmailFree(ctx, newEmail)
+	if err != nil {
+		return err
+	}
+
+	token, err := generateSecretToken()
+	if err != nil {
+		return err
+	}
+	tokenHash := hashSecretToken(token)
+
+	// only the latest requested change stays confirmable
+	accountKey := fmt.Sprintf(emailChangeAccountKeyTemplate, account.ID)
+	previous, err := uc.service.GetKV(ctx, accountKey)
+	if err == nil {
+		_, err = uc.service.DelKV(ctx, fmt.Sprintf(emailChangeTokenKeyTemplate, previous))
+	}
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		return err
+	}
+
+	err = uc.storeEmailChange(ctx, fmt.Sprintf(emailChangeTokenKeyTemplate, tokenHash), &emailChange{
+		AccountID: account.ID,
+		OldEmail:  account.Email,
+		NewEmail:  newEmail,
+	}, uc.config.EmailChangeTTL)
+	if err != nil {
+		return err
+	}
+
+	ok, err := uc.service.SetKVWithTTL(ctx, accountKey, tokenHash, uc.config.EmailChangeTTL)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrUnableToStoreKey
+	}
+
+	// the link goes to the new address, proving it belongs to the user
+	return uc.sendTemplateTo(account, newEmail, mailer.TemplateEmailChange, &mailer.Data{
+		NewEmail:  newEmail,
+		Link:      secretLink(uc.config.EmailChangeURL, token),
+		ExpiresAt: time.Now().Add(uc.config.EmailChangeTTL),
+	})
+}
+
+func (uc *accountUsecase) ConfirmEmailChange(ctx context.Context, token string) (bool, error) {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	ok, err := uc.confirmEmailChange(ctx, token)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return ok, err
+}
+
+// confirmEmailChange keeps the account ID, so issued tokens and sessions stay
+// valid. The old address gets a link to undo the change in case the account
+// was taken over.
+func (uc *accountUsecase) confirmEmailChange(ctx context.Context, token string) (bool, error) {
+	tokenKey := fmt.Sprintf(emailChangeTokenKeyTemplate, hashSecretToken(token))
+
+	change, err := uc.loadEmailChange(ctx, tokenKey)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := uc.service.DelKV(ctx, tokenKey, fmt.Sprintf(emailChangeAccountKeyTemplate, change.AccountID))
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, errs.ErrInvalidEmailChangeToken
+	}
+
+	account, err := uc.changedAccount(ctx, change)
+	if err != nil {
+		return false, err
+	}
+	if account.Email != change.OldEmail {
+		return false, errs.ErrInvalidEmailChangeToken
+	}
+
+	// the address might have been registered since the change was requested
+	err = uc.checkEmailFree(ctx, change.NewEmail)
+	if err != nil {
+		return false, err
+	}
+
+	revertToken, err := generateSecretToken()
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.storeEmailChange(ctx, fmt.Sprintf(emailRevertTokenKeyTemplate, hashSecretToken(revertToken)), change, uc.config.EmailRevertTTL)
+	if err != nil {
+		return false, err
+	}
+
+	previous := *account
+
+	account.Email = change.NewEmail
+	uc.markEmailVerified(account, actorSelf)
+
+	event, err := uc.accountEvent(eventModels.TypeEmailChanged, account)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = uc.repository.UpdateAccount(ctx, account, event)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.sendTemplate(&previous, mailer.TemplateEmailChanged, &mailer.Data{
+		NewEmail:  change.NewEmail,
+		Link:      secretLink(uc.config.EmailRevertURL, revertToken),
+		ExpiresAt: time.Now().Add(uc.config.EmailRevertTTL),
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// changedAccount loads the account a change belongs to. Links outlive a
+// purged account, they're just invalid then.
+func (uc *accountUsecase) changedAccount(ctx context.Context, change *emailChange) (*models.Account, error) {
+	account, err := uc.repository.GetAccountByID(ctx, change.AccountID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errs.ErrInvalidEmailChangeToken
+	}
+	return account, err
+}
+
+func (uc *accountUsecase) RevertEmailChange(ctx context.Context, token string) (bool, error) {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	ok, err := uc.revertEmailChange(ctx, token)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return ok, err
+}
+
+// revertEmailChange treats the change as a takeover: besides restoring the
+// old address it signs out every session, including the one that asked for
+// the change.
+func (uc *accountUsecase) revertEmailChange(ctx context.Context, token string) (bool, error) {
+	tokenKey := fmt.Sprintf(emailRevertTokenKeyTemplate, hashSecretToken(token))
+
+	change, err := uc.loadEmailChange(ctx, tokenKey)
+	if err != nil {
+		return false, err
+	}
+
+	account, err := uc.changedAccount(ctx, change)
+	if err != nil {
+		return false, err
+	}
+
+	if account.Email != change.OldEmail {
+		err = uc.checkEmailFree(ctx, change.OldEmail)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	n, err := uc.service.DelKV(ctx, tokenKey)
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, errs.ErrInvalidEmailChangeToken
+	}
+
+	// a change requested from the taken over account must not go through later
+	accountKey := fmt.Sprintf(emailChangeAccountKeyTemplate, account.ID)
+	pending, err := uc.service.GetKV(ctx, accountKey)
+	if err == nil {
+		_, err = uc.service.DelKV(ctx, fmt.Sprintf(emailChangeTokenKeyTemplate, pending), accountKey)
+	}
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		return false, err
+	}
+
+	account.Email = change.OldEmail
+	uc.markEmailVerified(account, actorSelf)
+
+	event, err := uc.accountEvent(eventModels.TypeEmailChangeReverted, account)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = uc.repository.UpdateAccount(ctx, account, event)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = uc.sessionCase.RevokeAccountSessions(ctx, account.ID)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.tokenCase.RevokeAccountTokens(ctx, account.ID)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (uc *accountUsecase) checkEmailFree(ctx context.Context, email string) error {
+	_, err := uc.repository.GetAccountByEmail(ctx, email)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return errs.ErrEmailTaken
+}
+
+func (uc *accountUsecase) storeEmailChange(ctx context.Context, key string, change *emailChange, ttl time.Duration) error {
+	value, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+
+	ok, err := uc.service.SetKVWithTTL(ctx, key, string(value), ttl)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrUnableToStoreKey
+	}
+	return nil
+}
+
+func (uc *accountUsecase) loadEmailChange(ctx context.Context, key string) (*emailChange, error) {
+	value, err := uc.service.GetKV(ctx, key)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errs.ErrInvalidEmailChangeToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var change emailChange
+	err = json.Unmarshal([]byte(value), &change)
+	if err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
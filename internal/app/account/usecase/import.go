@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/account"
+
+	eventModels "github.com/barugoo/oscillo-auth/internal/app/event"
+)
+
+func (uc *accountUsecase) ImportAccount(ctx context.Context, account *models.Account) (*models.Account, error) {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	account, err := uc.importAccount(ctx, account)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return account, err
+}
+
+// importAccount stores an account migrated from another system as is: the
+// password hash and 2FA secret are kept, so users sign in with their old
+// credentials and get upgraded to the current hash on their first login.
+// The ID is kept too if given.
+func (uc *accountUsecase) importAccount(ctx context.Context, account *models.Account) (*models.Account, error) {
+	email, err := uc.normalizeEmail(account.Email)
+	if err != nil {
+		return nil, err
+	}
+	account.Email = email
+
+	err = uc.hasher.Validate(account.PasswordHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errs.ErrInvalidPasswordHash, err)
+	}
+
+	if account.Has2FA() {
+		_, err = totp.GenerateCode(account.Secret2FA, time.Now())
+		if err != nil {
+			return nil, errs.ErrInvalid2FASecret
+		}
+	}
+
+	status := account.Status
+	switch status {
+	case "":
+		status = account.BaseStatus()
+	case models.StatusPending, models.StatusActive, models.StatusSuspended, models.StatusLocked:
+	default:
+		return nil, errs.ErrInvalidStatus
+	}
+	account.SetStatus(status, account.StatusReason, actorImport, account.StatusExpiresAt)
+
+	if account.CreatedAt.IsZero() {
+		account.CreatedAt = time.Now().UTC()
+	}
+	account.PasswordHistory = nil
+	account.DeletedAt = time.Time{}
+	account.PurgeAt = time.Time{}
+
+	event, err := uc.accountEvent(eventModels.TypeAccountImported, account)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repository.CreateAccount(ctx, account, event)
+}
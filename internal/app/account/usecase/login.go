@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/account"
+
+	sessionModels "github.com/barugoo/oscillo-auth/internal/app/session"
+
+	tokenModels "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+// dummyPassword is hashed once with the current policy and verified against
+// when a login targets an unknown email.
+const dummyPassword = "oscillo-auth-dummy-password"
+
+func (uc *accountUsecase) AuthByCredentials(ctx context.Context, cred *models.Credentials, client *sessionModels.ClientInfo) (*tokenModels.AuthResult, error) {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	result, err := uc.authByCredentials(ctx, cred, client)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return result, err
+}
+
+func (uc *accountUsecase) authByCredentials(ctx context.Context, cred *models.Credentials, client *sessionModels.ClientInfo) (*tokenModels.AuthResult, error) {
+	email, err := uc.normalizeEmail(cred.Email)
+	if err != nil {
+		return nil, err
+	}
+	cred.Email = email
+
+	account, err := uc.repository.GetAccountByEmail(ctx, cred.Email)
+	if errors.Is(err, errs.ErrNotFound) {
+		// burn the same amount of work as a real check so that response
+		// timing doesn't tell unknown emails apart from wrong passwords.
+		uc.verifyDummyPassword(cred.Password)
+		return nil, errs.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := uc.hasher.Verify(cred.Password, account.PasswordHash)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, errs.ErrInvalidCredentials
+	}
+
+	err = account.CheckStatus(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	uc.rehashPassword(ctx, account, cred.Password)
+
+	if account.Has2FA() {
+		challenge, err := uc.tokenCase.IssueChallengeToken(ctx, account)
+		if err != nil {
+			return nil, err
+		}
+		return &tokenModels.AuthResult{
+			Challenge: challenge,
+		}, nil
+	}
+
+	tokens, err := uc.makeAccountTokens(ctx, account, client)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenModels.AuthResult{
+		Tokens: tokens,
+	}, nil
+}
+
+func (uc *accountUsecase) CompleteLogin(ctx context.Context, challenge, code string, client *sessionModels.ClientInfo) (*tokenModels.TokenPair, error) {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	tokens, err := uc.completeLogin(ctx, challenge, code, client)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return tokens, err
+}
+
+func (uc *accountUsecase) completeLogin(ctx context.Context, challenge, code string, client *sessionModels.ClientInfo) (*tokenModels.TokenPair, error) {
+	claims, err := uc.tokenCase.ConsumeChallengeToken(ctx, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := uc.repository.GetAccountByID(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	err = account.CheckStatus(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if !account.Has2FA() {
+		return nil, errs.Err2FADisabled
+	}
+
+	valid := totp.Validate(code, account.Secret2FA)
+	if !valid {
+		return nil, errs.ErrInvalid2FACode
+	}
+
+	return uc.makeAccountTokens(ctx, account, client)
+}
+
+func (uc *accountUsecase) makeAccountTokens(ctx context.Context, account *models.Account, client *sessionModels.ClientInfo) (*tokenModels.TokenPair, error) {
+	session, err := uc.sessionCase.StartSession(ctx, account.ID, client)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := uc.tokenCase.IssueAccessToken(ctx, account, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh, err := uc.tokenCase.IssueRefreshToken(ctx, account, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokenModels.TokenPair{
+		Access:  access,
+		Refresh: refresh,
+	}, nil
+}
+
+// rehashPassword upgrades a stored hash to the current hashing policy. It runs
+// after a successful login, so failures are only logged and never block it.
+// Only the hash is written, and only while it's the one just verified.
+func (uc *accountUsecase) rehashPassword(ctx context.Context, account *models.Account, pwd string) {
+	if !uc.hasher.NeedsRehash(account.PasswordHash) {
+		return
+	}
+
+	hash, err := uc.hasher.Hash(pwd)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	ok, err := uc.repository.ReplacePasswordHash(ctx, account.ID, account.PasswordHash, hash)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if ok {
+		account.PasswordHash = hash
+		account.Version++
+	}
+}
+
+func (uc *accountUsecase) verifyDummyPassword(pwd string) {
+	uc.dummyOnce.Do(func() {
+		hash, err := uc.hasher.Hash(dummyPassword)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		uc.dummyHash = hash
+	})
+
+	if uc.dummyHash == "" {
+		return
+	}
+	uc.hasher.Verify(pwd, uc.dummyHash)
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+	"github.com/barugoo/oscillo-auth/internal/app/mailer"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/account"
+
+	eventModels "github.com/barugoo/oscillo-auth/internal/app/event"
+)
+
+const (
+	resetTokenKeyTemplate   = "password_reset:%s"
+	resetAccountKeyTemplate = "password_reset_account:%s"
+	resetRequestKeyTemplate = "password_reset_request:%s"
+)
+
+func (uc *accountUsecase) RequestPasswordReset(ctx context.Context, email string) error {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	err := uc.requestPasswordReset(ctx, email)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return err
+}
+
+// requestPasswordReset succeeds for unknown emails too, so callers can't use
+// it to find out which accounts exist. For the same reason it's rate limited
+// before the account lookup, and the token is issued in the background.
+func (uc *accountUsecase) requestPasswordReset(ctx context.Context, email string) error {
+	email, err := uc.normalizeEmail(email)
+	if err != nil {
+		return err
+	}
+
+	ok, err := uc.service.SetKVNX(ctx, fmt.Sprintf(resetRequestKeyTemplate, email), "1", uc.config.PasswordResetInterval)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrTooManyRequests
+	}
+
+	account, err := uc.repository.GetAccountByEmail(ctx, email)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		err := uc.sendPasswordReset(context.Background(), account)
+		if err != nil {
+			log.Printf("account %s: can't send password reset: %v", account.ID, err)
+		}
+	}()
+	return nil
+}
+
+// sendPasswordReset issues a fresh reset token, invalidating the one sent
+// before, and mails it to the account.
+func (uc *accountUsecase) sendPasswordReset(ctx context.Context, account *models.Account) error {
+	token, err := generateSecretToken()
+	if err != nil {
+		return err
+	}
+	tokenHash := hashSecretToken(token)
+
+	// only the latest requested token stays usable
+	accountKey := fmt.Sprintf(resetAccountKeyTemplate, account.ID)
+	previous, err := uc.service.GetKV(ctx, accountKey)
+	if err == nil {
+		_, err = uc.service.DelKV(ctx, fmt.Sprintf(resetTokenKeyTemplate, previous))
+	}
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		return err
+	}
+
+	ok, err := uc.service.SetKVWithTTL(ctx, fmt.Sprintf(resetTokenKeyTemplate, tokenHash), account.ID, uc.config.PasswordResetTTL)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrUnableToStoreKey
+	}
+
+	ok, err = uc.service.SetKVWithTTL(ctx, accountKey, tokenHash, uc.config.PasswordResetTTL)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrUnableToStoreKey
+	}
+
+	return uc.sendTemplate(account, mailer.TemplatePasswordReset, &mailer.Data{
+		Link:      secretLink(uc.config.PasswordResetURL, token),
+		ExpiresAt: time.Now().Add(uc.config.PasswordResetTTL),
+	})
+}
+
+func (uc *accountUsecase) ResetPassword(ctx context.Context, token, password string) error {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	err := uc.resetPassword(ctx, token, password)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return err
+}
+
+func (uc *accountUsecase) resetPassword(ctx context.Context, token, password string) error {
+	tokenKey := fmt.Sprintf(resetTokenKeyTemplate, hashSecretToken(token))
+
+	accountID, err := uc.service.GetKV(ctx, tokenKey)
+	if errors.Is(err, errs.ErrNotFound) {
+		return errs.ErrInvalidResetToken
+	}
+	if err != nil {
+		return err
+	}
+
+	account, err := uc.repository.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	// the policy is checked before the token is consumed, so a rejected
+	// password doesn't force the user to request another reset.
+	err = uc.policy.Check(password, account.Email)
+	if err != nil {
+		return err
+	}
+
+	err = uc.setPassword(account, password)
+	if err != nil {
+		return err
+	}
+
+	n, err := uc.service.DelKV(ctx, tokenKey, fmt.Sprintf(resetAccountKeyTemplate, account.ID))
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errs.ErrInvalidResetToken
+	}
+
+	event, err := uc.accountEvent(eventModels.TypeAccountPasswordChanged, account)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.repository.UpdateAccount(ctx, account, event)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.sessionCase.RevokeAccountSessions(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	err = uc.tokenCase.RevokeAccountTokens(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+	return uc.sendTemplate(account, mailer.TemplatePasswordChanged, &mailer.Data{})
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/account"
+
+	eventModels "github.com/barugoo/oscillo-auth/internal/app/event"
+)
+
+func (uc *accountUsecase) SuspendAccount(ctx context.Context, email string, suspension *models.Suspension) (bool, error) {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	ok, err := uc.suspendAccount(ctx, email, suspension)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return ok, err
+}
+
+// suspendAccount blocks the account and signs it out everywhere. A zero
+// ExpiresAt keeps it blocked until UnsuspendAccount.
+func (uc *accountUsecase) suspendAccount(ctx context.Context, email string, suspension *models.Suspension) (bool, error) {
+	email, err := uc.normalizeEmail(email)
+	if err != nil {
+		return false, err
+	}
+
+	account, err := uc.repository.GetAccountByEmail(ctx, email)
+	if err != nil {
+		return false, err
+	}
+
+	if account.IsDeleted() {
+		return false, errs.ErrAccountDeleted
+	}
+
+	status, eventType := models.StatusSuspended, eventModels.TypeAccountSuspended
+	if suspension.Lock {
+		status, eventType = models.StatusLocked, eventModels.TypeAccountLocked
+	}
+	account.SetStatus(status, suspension.Reason, suspension.Actor, suspension.ExpiresAt)
+
+	event, err := uc.accountEvent(eventType, account)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = uc.repository.UpdateAccount(ctx, account, event)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = uc.sessionCase.RevokeAccountSessions(ctx, account.ID)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.tokenCase.RevokeAccountTokens(ctx, account.ID)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (uc *accountUsecase) UnsuspendAccount(ctx context.Context, email, actor string) (bool, error) {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	ok, err := uc.unsuspendAccount(ctx, email, actor)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return ok, err
+}
+
+func (uc *accountUsecase) unsuspendAccount(ctx context.Context, email, actor string) (bool, error) {
+	email, err := uc.normalizeEmail(email)
+	if err != nil {
+		return false, err
+	}
+
+	account, err := uc.repository.GetAccountByEmail(ctx, email)
+	if err != nil {
+		return false, err
+	}
+
+	switch account.CurrentStatus(time.Now()) {
+	case models.StatusSuspended, models.StatusLocked:
+	default:
+		return false, errs.ErrAccountNotSuspended
+	}
+
+	account.SetStatus(account.BaseStatus(), "", actor, time.Time{})
+
+	event, err := uc.accountEvent(eventModels.TypeAccountUnsuspended, account)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = uc.repository.UpdateAccount(ctx, account, event)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
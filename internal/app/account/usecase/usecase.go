@@ -3,53 +3,108 @@ package usecase
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"image/png"
+	"log"
+	"net/url"
+	"sync"
+	"time"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/pquerna/otp"
 	"github.com/pquerna/otp/totp"
-	"golang.org/x/crypto/bcrypt"
 
 	"github.com/barugoo/oscillo-auth/config"
 
 	"github.com/barugoo/oscillo-auth/internal/app/errors"
+	"github.com/barugoo/oscillo-auth/internal/app/mailer"
+	"github.com/barugoo/oscillo-auth/internal/app/password"
 	"github.com/barugoo/oscillo-auth/internal/app/service"
 
 	models "github.com/barugoo/oscillo-auth/internal/app/account"
 	"github.com/barugoo/oscillo-auth/internal/app/account/repository"
+
+	eventModels "github.com/barugoo/oscillo-auth/internal/app/event"
+
+	sessionModels "github.com/barugoo/oscillo-auth/internal/app/session"
+	sessionUsecase "github.com/barugoo/oscillo-auth/internal/app/session/usecase"
+
+	tokenModels "github.com/barugoo/oscillo-auth/internal/app/token"
+	tokenUsecase "github.com/barugoo/oscillo-auth/internal/app/token/usecase"
 )
 
 type AccountUsecase interface {
-	RegisterWithCredentials(ctx context.Context, cred *models.Credentials) (bool, error)
-	AuthByCredentials(ctx context.Context, cred *models.Credentials) (string, error)
+	RegisterWithCredentials(ctx context.Context, cred *models.Credentials) (*models.Account, error)
+	AuthByCredentials(ctx context.Context, cred *models.Credentials, client *sessionModels.ClientInfo) (*tokenModels.AuthResult, error)
+	CompleteLogin(ctx context.Context, challenge, code string, client *sessionModels.ClientInfo) (*tokenModels.TokenPair, error)
+	DeleteAccount(ctx context.Context, accessToken, password, code string) (time.Time, error)
+	CancelDeletion(ctx context.Context, cred *models.Credentials, code string) (bool, error)
+	PurgeDeletedAccounts(ctx context.Context) (int, error)
 	UpdateCredentials(ctx context.Context, cred *models.Credentials) (bool, error)
+	ChangePassword(ctx context.Context, accessToken, current, password, code string) (bool, error)
+	RequestEmailChange(ctx context.Context, accessToken, newEmail, password, code string) error
+	ConfirmEmailChange(ctx context.Context, token string) (bool, error)
+	RevertEmailChange(ctx context.Context, token string) (bool, error)
 	ActivateAccount(ctx context.Context, email string) (bool, error)
-	Generate2FA(ctx context.Context, email string) ([]byte, error)
-	Setup2FA(ctx context.Context, email, code string) (bool, error)
-	Remove2FA(ctx context.Context, email, code string) (bool, error)
-	Verify2FA(ctx context.Context, email, code string) (bool, error)
+	SuspendAccount(ctx context.Context, email string, suspension *models.Suspension) (bool, error)
+	UnsuspendAccount(ctx context.Context, email, actor string) (bool, error)
+	VerifyEmail(ctx context.Context, token string) (bool, error)
+	ResendVerification(ctx context.Context, email string) error
+	Generate2FA(ctx context.Context, accessToken, password, code string) ([]byte, error)
+	Setup2FA(ctx context.Context, accessToken, password, currentCode, code string) (bool, error)
+	Remove2FA(ctx context.Context, accessToken, password, code string) (bool, error)
+	Verify2FA(ctx context.Context, accessToken, code string) (bool, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, password string) error
+	GetAccount(ctx context.Context, id, email string) (*models.Account, error)
+	ListAccounts(ctx context.Context, filter *models.AccountFilter, cursor string, limit int) ([]*models.Account, string, error)
+	ImportAccount(ctx context.Context, account *models.Account) (*models.Account, error)
 }
 
 const (
 	usecaseMethodTemplate = "%s/usecase"
+
+	// actors recorded with account status changes
+	actorSelf   = "self"
+	actorAdmin  = "admin"
+	actorImport = "import"
+
+	secretTokenBytes = 32
+
+	reauthAttemptsKeyTemplate = "reauth_attempts:%s"
 )
 
 type accountUsecase struct {
-	service    service.AuthService
-	config     *config.ServiceConfig
-	repository repository.AccountRepository
+	service     service.AuthService
+	config      *config.ServiceConfig
+	repository  repository.AccountRepository
+	hasher      password.Hasher
+	policy      password.Policy
+	mailer      mailer.Mailer
+	tokenCase   tokenUsecase.TokenUsecase
+	sessionCase sessionUsecase.SessionUsecase
+
+	dummyOnce sync.Once
+	dummyHash string
 }
 
-func NewAccountUsecase(config *config.ServiceConfig, service service.AuthService, repository repository.AccountRepository) AccountUsecase {
+func NewAccountUsecase(config *config.ServiceConfig, service service.AuthService, repository repository.AccountRepository, hasher password.Hasher, policy password.Policy, mailer mailer.Mailer, tokenUsecase tokenUsecase.TokenUsecase, sessionUsecase sessionUsecase.SessionUsecase) AccountUsecase {
 	return &accountUsecase{
-		config:     config,
-		service:    service,
-		repository: repository,
+		config:      config,
+		service:     service,
+		repository:  repository,
+		hasher:      hasher,
+		policy:      policy,
+		mailer:      mailer,
+		tokenCase:   tokenUsecase,
+		sessionCase: sessionUsecase,
 	}
 }
 
-func (uc *accountUsecase) RegisterWithCredentials(ctx context.Context, cred *models.Credentials) (bool, error) {
+func (uc *accountUsecase) RegisterWithCredentials(ctx context.Context, cred *models.Credentials) (*models.Account, error) {
 	methodName := uc.getMethodFromContext(ctx)
 
 	span := uc.service.StartSpan(ctx, methodName)
@@ -57,33 +112,58 @@ func (uc *accountUsecase) RegisterWithCredentials(ctx context.Context, cred *mod
 
 	ctx = uc.service.ContextWithSpan(context.Background(), span)
 
-	ok, err := uc.registerWithCredentials(ctx, cred)
+	account, err := uc.registerWithCredentials(ctx, cred)
 	if err != nil {
 		err = uc.wrapError(err, methodName)
 	}
-	return ok, err
+	return account, err
 }
 
-func (uc *accountUsecase) registerWithCredentials(ctx context.Context, cred *models.Credentials) (bool, error) {
-	hash, err := uc.hash(cred.Password)
+func (uc *accountUsecase) registerWithCredentials(ctx context.Context, cred *models.Credentials) (*models.Account, error) {
+	email, err := uc.normalizeEmail(cred.Email)
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+	cred.Email = email
+
+	err = uc.policy.Check(cred.Password, cred.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := uc.hasher.Hash(cred.Password)
+	if err != nil {
+		return nil, err
 	}
 
 	account := &models.Account{
 		Email:        cred.Email,
 		PasswordHash: hash,
-		IsActive:     false,
+		Locale:       cred.Locale,
+		CreatedAt:    time.Now().UTC(),
 	}
+	account.SetStatus(models.StatusPending, "", actorSelf, time.Time{})
 
-	_, err = uc.repository.CreateAccount(ctx, account)
+	event, err := uc.accountEvent(eventModels.TypeAccountRegistered, account)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	return true, err
+
+	account, err = uc.repository.CreateAccount(ctx, account, event)
+	if err != nil {
+		return nil, err
+	}
+
+	// the account is there either way, failing now would only make a retry
+	// run into AlreadyExists. ResendVerification sends the mail again.
+	err = uc.sendVerification(ctx, account)
+	if err != nil {
+		log.Printf("account %s: can't send verification: %v", account.ID, err)
+	}
+	return account, nil
 }
 
-func (uc *accountUsecase) AuthByCredentials(ctx context.Context, cred *models.Credentials) (string, error) {
+func (uc *accountUsecase) UpdateCredentials(ctx context.Context, cred *models.Credentials) (bool, error) {
 	methodName := uc.getMethodFromContext(ctx)
 
 	span := uc.service.StartSpan(ctx, methodName)
@@ -91,36 +171,61 @@ func (uc *accountUsecase) AuthByCredentials(ctx context.Context, cred *models.Cr
 
 	ctx = uc.service.ContextWithSpan(context.Background(), span)
 
-	ok, err := uc.authByCredentials(ctx, cred)
+	ok, err := uc.updateCredentials(ctx, cred)
 	if err != nil {
 		err = uc.wrapError(err, methodName)
 	}
 	return ok, err
 }
 
-func (uc *accountUsecase) authByCredentials(ctx context.Context, cred *models.Credentials) (string, error) {
-	hash, err := uc.hash(cred.Password)
+// updateCredentials is the admin override for a user's password. Users go
+// through ChangePassword or the reset flow. The account is signed out
+// everywhere, as after a reset.
+func (uc *accountUsecase) updateCredentials(ctx context.Context, cred *models.Credentials) (bool, error) {
+	email, err := uc.normalizeEmail(cred.Email)
 	if err != nil {
-		return "", err
+		return false, err
+	}
+	cred.Email = email
+
+	err = uc.policy.Check(cred.Password, cred.Email)
+	if err != nil {
+		return false, err
 	}
 
 	account, err := uc.repository.GetAccountByEmail(ctx, cred.Email)
 	if err != nil {
-		return "", err
+		return false, err
 	}
 
-	if !account.IsActive {
-		return "", errors.ErrInactiveAccount
+	err = uc.setPassword(account, cred.Password)
+	if err != nil {
+		return false, err
+	}
+
+	event, err := uc.accountEvent(eventModels.TypeAccountPasswordChanged, account)
+	if err != nil {
+		return false, err
 	}
 
-	if account.PasswordHash != hash {
-		return "", errors.ErrWrongPassword
+	account, err = uc.repository.UpdateAccount(ctx, account, event)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = uc.sessionCase.RevokeAccountSessions(ctx, account.ID)
+	if err != nil {
+		return false, err
 	}
 
-	return uc.makeAccountToken(account)
+	err = uc.tokenCase.RevokeAccountTokens(ctx, account.ID)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
-func (uc *accountUsecase) UpdateCredentials(ctx context.Context, cred *models.Credentials) (bool, error) {
+func (uc *accountUsecase) ChangePassword(ctx context.Context, accessToken, current, password, code string) (bool, error) {
 	methodName := uc.getMethodFromContext(ctx)
 
 	span := uc.service.StartSpan(ctx, methodName)
@@ -128,30 +233,60 @@ func (uc *accountUsecase) UpdateCredentials(ctx context.Context, cred *models.Cr
 
 	ctx = uc.service.ContextWithSpan(context.Background(), span)
 
-	ok, err := uc.updateCredentials(ctx, cred)
+	ok, err := uc.changePassword(ctx, accessToken, current, password, code)
 	if err != nil {
 		err = uc.wrapError(err, methodName)
 	}
 	return ok, err
 }
 
-func (uc *accountUsecase) updateCredentials(ctx context.Context, cred *models.Credentials) (bool, error) {
-	hash, err := uc.hash(cred.Password)
+func (uc *accountUsecase) changePassword(ctx context.Context, accessToken, current, password, code string) (bool, error) {
+	claims, err := uc.tokenCase.ParseAccessToken(ctx, accessToken)
 	if err != nil {
 		return false, err
 	}
 
-	account, err := uc.repository.GetAccountByEmail(ctx, cred.Email)
+	account, err := uc.repository.GetAccountByID(ctx, claims.Subject)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.reauthenticate(ctx, account, current, code)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.policy.Check(password, account.Email)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.setPassword(account, password)
+	if err != nil {
+		return false, err
+	}
+
+	event, err := uc.accountEvent(eventModels.TypeAccountPasswordChanged, account)
 	if err != nil {
 		return false, err
 	}
-	account.PasswordHash = hash
 
-	account, err = uc.repository.UpdateAccount(ctx, account)
+	_, err = uc.repository.UpdateAccount(ctx, account, event)
 	if err != nil {
 		return false, err
 	}
-	return true, err
+
+	// the caller keeps its session, every other device has to log in again
+	_, err = uc.sessionCase.RevokeOtherSessions(ctx, accessToken)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.sendTemplate(account, mailer.TemplatePasswordChanged, &mailer.Data{})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 func (uc *accountUsecase) ActivateAccount(ctx context.Context, email string) (bool, error) {
@@ -170,21 +305,31 @@ func (uc *accountUsecase) ActivateAccount(ctx context.Context, email string) (bo
 }
 
 func (uc *accountUsecase) activateAccount(ctx context.Context, email string) (bool, error) {
+	email, err := uc.normalizeEmail(email)
+	if err != nil {
+		return false, err
+	}
+
 	account, err := uc.repository.GetAccountByEmail(ctx, email)
 	if err != nil {
 		return false, err
 	}
 
-	account.IsActive = true
+	uc.markEmailVerified(account, actorAdmin)
+
+	event, err := uc.accountEvent(eventModels.TypeAccountActivated, account)
+	if err != nil {
+		return false, err
+	}
 
-	_, err = uc.repository.UpdateAccount(ctx, account)
+	_, err = uc.repository.UpdateAccount(ctx, account, event)
 	if err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
-func (uc *accountUsecase) Generate2FA(ctx context.Context, email string) ([]byte, error) {
+func (uc *accountUsecase) Generate2FA(ctx context.Context, accessToken, password, code string) ([]byte, error) {
 	methodName := uc.getMethodFromContext(ctx)
 
 	span := uc.service.StartSpan(ctx, methodName)
@@ -192,15 +337,27 @@ func (uc *accountUsecase) Generate2FA(ctx context.Context, email string) ([]byte
 
 	ctx = uc.service.ContextWithSpan(context.Background(), span)
 
-	ok, err := uc.generate2FA(ctx, email)
+	ok, err := uc.generate2FA(ctx, accessToken, password, code)
 	if err != nil {
 		err = uc.wrapError(err, methodName)
 	}
 	return ok, err
 }
 
-func (uc *accountUsecase) generate2FA(ctx context.Context, email string) ([]byte, error) {
-	account, err := uc.repository.GetAccountByEmail(ctx, email)
+// generate2FA stores a new secret for setup2FA to confirm. Replacing an
+// enabled secret takes a code of the current one.
+func (uc *accountUsecase) generate2FA(ctx context.Context, accessToken, password, code string) ([]byte, error) {
+	claims, err := uc.tokenCase.ParseAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := uc.repository.GetAccountByID(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.reauthenticate(ctx, account, password, code)
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +381,7 @@ func (uc *accountUsecase) generate2FA(ctx context.Context, email string) ([]byte
 	return uc.genQRCode(key)
 }
 
-func (uc *accountUsecase) Setup2FA(ctx context.Context, email, code string) (bool, error) {
+func (uc *accountUsecase) Setup2FA(ctx context.Context, accessToken, password, currentCode, code string) (bool, error) {
 	methodName := uc.getMethodFromContext(ctx)
 
 	span := uc.service.StartSpan(ctx, methodName)
@@ -232,15 +389,25 @@ func (uc *accountUsecase) Setup2FA(ctx context.Context, email, code string) (boo
 
 	ctx = uc.service.ContextWithSpan(context.Background(), span)
 
-	ok, err := uc.setup2FA(ctx, email, code)
+	ok, err := uc.setup2FA(ctx, accessToken, password, currentCode, code)
 	if err != nil {
 		err = uc.wrapError(err, methodName)
 	}
 	return ok, err
 }
 
-func (uc *accountUsecase) setup2FA(ctx context.Context, email, code string) (bool, error) {
-	account, err := uc.repository.GetAccountByEmail(ctx, email)
+func (uc *accountUsecase) setup2FA(ctx context.Context, accessToken, password, currentCode, code string) (bool, error) {
+	claims, err := uc.tokenCase.ParseAccessToken(ctx, accessToken)
+	if err != nil {
+		return false, err
+	}
+
+	account, err := uc.repository.GetAccountByID(ctx, claims.Subject)
+	if err != nil {
+		return false, err
+	}
+
+	err = uc.reauthenticate(ctx, account, password, currentCode)
 	if err != nil {
 		return false, err
 	}
@@ -257,7 +424,12 @@ func (uc *accountUsecase) setup2FA(ctx context.Context, email, code string) (boo
 
 	account.Secret2FA = secret
 
-	_, err = uc.repository.UpdateAccount(ctx, account)
+	event, err := uc.accountEvent(eventModels.Type2FAEnabled, account)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = uc.repository.UpdateAccount(ctx, account, event)
 	if err != nil {
 		return false, err
 	}
@@ -265,7 +437,7 @@ func (uc *accountUsecase) setup2FA(ctx context.Context, email, code string) (boo
 	return valid, nil
 }
 
-func (uc *accountUsecase) Remove2FA(ctx context.Context, email, code string) (bool, error) {
+func (uc *accountUsecase) Remove2FA(ctx context.Context, accessToken, password, code string) (bool, error) {
 	methodName := uc.getMethodFromContext(ctx)
 
 	span := uc.service.StartSpan(ctx, methodName)
@@ -273,15 +445,20 @@ func (uc *accountUsecase) Remove2FA(ctx context.Context, email, code string) (bo
 
 	ctx = uc.service.ContextWithSpan(context.Background(), span)
 
-	ok, err := uc.remove2FA(ctx, email, code)
+	ok, err := uc.remove2FA(ctx, accessToken, password, code)
 	if err != nil {
 		err = uc.wrapError(err, methodName)
 	}
 	return ok, err
 }
 
-func (uc *accountUsecase) remove2FA(ctx context.Context, email, code string) (bool, error) {
-	account, err := uc.repository.GetAccountByEmail(ctx, email)
+func (uc *accountUsecase) remove2FA(ctx context.Context, accessToken, password, code string) (bool, error) {
+	claims, err := uc.tokenCase.ParseAccessToken(ctx, accessToken)
+	if err != nil {
+		return false, err
+	}
+
+	account, err := uc.repository.GetAccountByID(ctx, claims.Subject)
 	if err != nil {
 		return false, err
 	}
@@ -290,21 +467,26 @@ func (uc *accountUsecase) remove2FA(ctx context.Context, email, code string) (bo
 		return false, errors.Err2FADisabled
 	}
 
-	valid := totp.Validate(code, account.Secret2FA)
-	if !valid {
-		return false, errors.ErrInvalid2FACode
+	err = uc.reauthenticate(ctx, account, password, code)
+	if err != nil {
+		return false, err
 	}
 
 	account.Secret2FA = ""
 
-	_, err = uc.repository.UpdateAccount(ctx, account)
+	event, err := uc.accountEvent(eventModels.Type2FADisabled, account)
 	if err != nil {
 		return false, err
 	}
-	return valid, nil
+
+	_, err = uc.repository.UpdateAccount(ctx, account, event)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
-func (uc *accountUsecase) Verify2FA(ctx context.Context, email, code string) (bool, error) {
+func (uc *accountUsecase) Verify2FA(ctx context.Context, accessToken, code string) (bool, error) {
 	methodName := uc.getMethodFromContext(ctx)
 
 	span := uc.service.StartSpan(ctx, methodName)
@@ -312,15 +494,20 @@ func (uc *accountUsecase) Verify2FA(ctx context.Context, email, code string) (bo
 
 	ctx = uc.service.ContextWithSpan(context.Background(), span)
 
-	ok, err := uc.verify2FA(ctx, email, code)
+	ok, err := uc.verify2FA(ctx, accessToken, code)
 	if err != nil {
 		err = uc.wrapError(err, methodName)
 	}
 	return ok, err
 }
 
-func (uc *accountUsecase) verify2FA(ctx context.Context, email, code string) (bool, error) {
-	account, err := uc.repository.GetAccountByEmail(ctx, email)
+func (uc *accountUsecase) verify2FA(ctx context.Context, accessToken, code string) (bool, error) {
+	claims, err := uc.tokenCase.ParseAccessToken(ctx, accessToken)
+	if err != nil {
+		return false, err
+	}
+
+	account, err := uc.repository.GetAccountByID(ctx, claims.Subject)
 	if err != nil {
 		return false, err
 	}
@@ -329,11 +516,20 @@ func (uc *accountUsecase) verify2FA(ctx context.Context, email, code string) (bo
 		return false, errors.Err2FADisabled
 	}
 
-	valid := totp.Validate(code, account.Secret2FA)
-	if !valid {
+	err = uc.takeReauthAttempt(ctx, account)
+	if err != nil {
+		return false, err
+	}
+
+	if !totp.Validate(code, account.Secret2FA) {
 		return false, errors.ErrInvalid2FACode
 	}
-	return valid, nil
+
+	err = uc.returnReauthAttempt(ctx, account)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 func (uc *accountUsecase) genQRCode(key *otp.Key) ([]byte, error) {
@@ -351,24 +547,177 @@ func (uc *accountUsecase) genQRCode(key *otp.Key) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func (uc *accountUsecase) makeAccountToken(account *models.Account) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"email":   account.Email,
-		"has_2fa": account.Has2FA(),
-	})
-	return token.SignedString(uc.config.AppSecret)
+// markEmailVerified also activates the account, unless it's restricted.
+func (uc *accountUsecase) markEmailVerified(account *models.Account, actor string) {
+	account.EmailVerified = true
+	if account.CurrentStatus(time.Now()) == models.StatusPending {
+		account.SetStatus(models.StatusActive, "", actor, time.Time{})
+	}
+}
+
+// reauthenticate confirms a sensitive operation with the account's password
+// and, when 2FA is on, a TOTP code. Both fail with the same error and count
+// against the same limit, so knowing the password doesn't open the codes to
+// guessing.
+func (uc *accountUsecase) reauthenticate(ctx context.Context, account *models.Account, pwd, code string) error {
+	err := uc.takeReauthAttempt(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	valid, err := uc.hasher.Verify(pwd, account.PasswordHash)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return errors.ErrInvalidCredentials
+	}
+
+	if account.Has2FA() && !totp.Validate(code, account.Secret2FA) {
+		return errors.ErrInvalidCredentials
+	}
+	return uc.returnReauthAttempt(ctx, account)
 }
 
-func (uc *accountUsecase) hash(pwd string) (string, error) {
-	hash, err := bcrypt.GenerateFromPassword([]byte(pwd), bcrypt.MinCost)
+// takeReauthAttempt counts an attempt against the account's limit before
+// anything is checked, so parallel guesses can't all get under it.
+func (uc *accountUsecase) takeReauthAttempt(ctx context.Context, account *models.Account) error {
+	n, err := uc.service.IncrKV(ctx, fmt.Sprintf(reauthAttemptsKeyTemplate, account.ID), 1, uc.config.ReauthAttemptWindow)
+	if err != nil {
+		return err
+	}
+	if n > int64(uc.config.ReauthMaxAttempts) {
+		return errors.ErrTooManyRequests
+	}
+	return nil
+}
+
+// returnReauthAttempt gives a successful attempt back, only failures count.
+func (uc *accountUsecase) returnReauthAttempt(ctx context.Context, account *models.Account) error {
+	_, err := uc.service.IncrKV(ctx, fmt.Sprintf(reauthAttemptsKeyTemplate, account.ID), -1, uc.config.ReauthAttemptWindow)
+	return err
+}
+
+// setPassword replaces the account's password hash, refusing passwords that
+// match the current one or any kept in the account's password history.
+func (uc *accountUsecase) setPassword(account *models.Account, pwd string) error {
+	depth := uc.config.PasswordHistoryDepth
+	if depth > 0 {
+		previous := append([]string{account.PasswordHash}, account.PasswordHistory...)
+		if len(previous) > depth {
+			previous = previous[:depth]
+		}
+
+		for _, encoded := range previous {
+			if encoded == "" {
+				continue
+			}
+			reused, err := uc.hasher.Verify(pwd, encoded)
+			if err != nil {
+				return err
+			}
+			if reused {
+				return &errors.PolicyError{
+					Violations: []errors.PolicyViolation{{
+						Rule:        password.RuleHistory,
+						Description: fmt.Sprintf("must not match any of the last %d passwords", depth),
+					}},
+				}
+			}
+		}
+	}
+
+	hash, err := uc.hasher.Hash(pwd)
+	if err != nil {
+		return err
+	}
+
+	// the new hash counts as one of the last passwords itself
+	keep := depth - 1
+	if keep < 0 {
+		keep = 0
+	}
+
+	history := account.PasswordHistory
+	if account.PasswordHash != "" {
+		history = append([]string{account.PasswordHash}, history...)
+	}
+	if len(history) > keep {
+		history = history[:keep]
+	}
+
+	account.PasswordHash = hash
+	account.PasswordHistory = history
+	return nil
+}
+
+// sendMail delivers in the background, so that flows which must not reveal
+// whether an account exists answer as fast for known emails as for unknown.
+func (uc *accountUsecase) sendMail(msg *mailer.Message) {
+	go func() {
+		err := uc.mailer.Send(context.Background(), msg)
+		if err != nil {
+			log.Println(err)
+		}
+	}()
+}
+
+// sendTemplate renders right away, so template errors reach the caller, and
+// leaves only the delivery to the background.
+func (uc *accountUsecase) sendTemplate(account *models.Account, name string, data *mailer.Data) error {
+	return uc.sendTemplateTo(account, account.Email, name, data)
+}
+
+// sendTemplateTo is sendTemplate for mail that goes to another address than
+// the account's current one.
+func (uc *accountUsecase) sendTemplateTo(account *models.Account, to, name string, data *mailer.Data) error {
+	data.Email = account.Email
+
+	msg, err := mailer.Render(name, account.Locale, to, data)
+	if err != nil {
+		return err
+	}
+
+	uc.sendMail(msg)
+	return nil
+}
+
+func (uc *accountUsecase) normalizeEmail(email string) (string, error) {
+	return models.NormalizeEmail(email, uc.config.EmailProviderRules)
+}
+
+func secretLink(base, token string) string {
+	if base == "" {
+		return token
+	}
+	return fmt.Sprintf("%s?token=%s", base, url.QueryEscape(token))
+}
+
+func generateSecretToken() (string, error) {
+	b := make([]byte, secretTokenBytes)
+	_, err := rand.Read(b)
 	if err != nil {
 		return "", err
 	}
-	return string(hash), err
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashSecretToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (uc *accountUsecase) accountEvent(eventType string, account *models.Account) (*eventModels.Event, error) {
+	return eventModels.NewEvent(eventType, account.ID, &eventModels.AccountPayload{
+		Email: account.Email,
+	})
 }
 
 func (uc *accountUsecase) wrapError(err error, method string) error {
-	return &errors.UsecaseError{method, err}
+	return &errors.UsecaseError{
+		Method: method,
+		Err:    err,
+	}
 }
 
 func (uc *accountUsecase) getMethodFromContext(ctx context.Context) string {
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+	"github.com/barugoo/oscillo-auth/internal/app/mailer"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/account"
+
+	eventModels "github.com/barugoo/oscillo-auth/internal/app/event"
+)
+
+const (
+	verificationTokenKeyTemplate   = "email_verification:%s"
+	verificationAccountKeyTemplate = "email_verification_account:%s"
+	verificationResendKeyTemplate  = "email_verification_resend:%s"
+)
+
+func (uc *accountUsecase) VerifyEmail(ctx context.Context, token string) (bool, error) {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	ok, err := uc.verifyEmail(ctx, token)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return ok, err
+}
+
+func (uc *accountUsecase) verifyEmail(ctx context.Context, token string) (bool, error) {
+	tokenKey := fmt.Sprintf(verificationTokenKeyTemplate, hashSecretToken(token))
+
+	accountID, err := uc.service.GetKV(ctx, tokenKey)
+	if errors.Is(err, errs.ErrNotFound) {
+		return false, errs.ErrInvalidVerificationToken
+	}
+	if err != nil {
+		return false, err
+	}
+
+	n, err := uc.service.DelKV(ctx, tokenKey, fmt.Sprintf(verificationAccountKeyTemplate, accountID))
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, errs.ErrInvalidVerificationToken
+	}
+
+	account, err := uc.repository.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return false, err
+	}
+
+	uc.markEmailVerified(account, actorSelf)
+
+	event, err := uc.accountEvent(eventModels.TypeAccountActivated, account)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = uc.repository.UpdateAccount(ctx, account, event)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (uc *accountUsecase) ResendVerification(ctx context.Context, email string) error {
+	methodName := uc.getMethodFromContext(ctx)
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	err := uc.resendVerification(ctx, email)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return err
+}
+
+// resendVerification is rate limited before the account lookup, so unknown
+// and already verified emails are throttled the same way as pending ones.
+func (uc *accountUsecase) resendVerification(ctx context.Context, email string) error {
+	email, err := uc.normalizeEmail(email)
+	if err != nil {
+		return err
+	}
+
+	ok, err := uc.service.SetKVNX(ctx, fmt.Sprintf(verificationResendKeyTemplate, email), "1", uc.config.VerificationResendInterval)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrTooManyRequests
+	}
+
+	account, err := uc.repository.GetAccountByEmail(ctx, email)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if account.EmailVerified {
+		return nil
+	}
+	return uc.sendVerification(ctx, account)
+}
+
+// sendVerification issues a fresh verification token, invalidating the one
+// sent before, and mails it to the account.
+func (uc *accountUsecase) sendVerification(ctx context.Context, account *models.Account) error {
+	token, err := generateSecretToken()
+	if err != nil {
+		return err
+	}
+	tokenHash := hashSecretToken(token)
+
+	accountKey := fmt.Sprintf(verificationAccountKeyTemplate, account.ID)
+	previous, err := uc.service.GetKV(ctx, accountKey)
+	if err == nil {
+		_, err = uc.service.DelKV(ctx, fmt.Sprintf(verificationTokenKeyTemplate, previous))
+	}
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		return err
+	}
+
+	ok, err := uc.service.SetKVWithTTL(ctx, fmt.Sprintf(verificationTokenKeyTemplate, tokenHash), account.ID, uc.config.EmailVerificationTTL)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrUnableToStoreKey
+	}
+
+	ok, err = uc.service.SetKVWithTTL(ctx, accountKey, tokenHash, uc.config.EmailVerificationTTL)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrUnableToStoreKey
+	}
+
+	return uc.sendTemplate(account, mailer.TemplateEmailVerification, &mailer.Data{
+		Link:      secretLink(uc.config.EmailVerificationURL, token),
+		ExpiresAt: time.Now().Add(uc.config.EmailVerificationTTL),
+	})
+}
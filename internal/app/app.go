@@ -1,8 +1,12 @@
 package app
 
 import (
+	"context"
 	"io"
+	"log"
 	"net"
+	"net/http"
+	"time"
 
 	"github.com/go-redis/redis/v7"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -12,11 +16,25 @@ import (
 	"github.com/barugoo/oscillo-auth/config"
 	"github.com/barugoo/oscillo-auth/init/tracer"
 
+	"github.com/barugoo/oscillo-auth/internal/app/mailer"
+	"github.com/barugoo/oscillo-auth/internal/app/password"
 	"github.com/barugoo/oscillo-auth/internal/app/service"
 
+	accountModels "github.com/barugoo/oscillo-auth/internal/app/account"
 	accountDelivery "github.com/barugoo/oscillo-auth/internal/app/account/delivery"
 	accountRepository "github.com/barugoo/oscillo-auth/internal/app/account/repository"
 	accountUsecase "github.com/barugoo/oscillo-auth/internal/app/account/usecase"
+
+	eventPublisher "github.com/barugoo/oscillo-auth/internal/app/event/publisher"
+	eventRepository "github.com/barugoo/oscillo-auth/internal/app/event/repository"
+	eventUsecase "github.com/barugoo/oscillo-auth/internal/app/event/usecase"
+
+	sessionRepository "github.com/barugoo/oscillo-auth/internal/app/session/repository"
+	sessionUsecase "github.com/barugoo/oscillo-auth/internal/app/session/usecase"
+
+	tokenDelivery "github.com/barugoo/oscillo-auth/internal/app/token/delivery"
+	tokenRepository "github.com/barugoo/oscillo-auth/internal/app/token/repository"
+	tokenUsecase "github.com/barugoo/oscillo-auth/internal/app/token/usecase"
 )
 
 type App interface {
@@ -26,12 +44,19 @@ type App interface {
 
 type authApp struct {
 	grpcServer   *grpc.Server
+	httpServer   *http.Server
 	config       *config.ServiceConfig
 	tracerCloser io.Closer
+	tokenCase    tokenUsecase.TokenUsecase
+	eventCase    eventUsecase.EventUsecase
+	accountCase  accountUsecase.AccountUsecase
+	stop         chan struct{}
 }
 
 const (
 	accountCollection = "account"
+	keyCollection     = "signing_key"
+	outboxCollection  = "outbox"
 )
 
 func NewAuthApp(config *config.ServiceConfig, redis *redis.Client, db *mongo.Database) (App, error) {
@@ -43,17 +68,92 @@ func NewAuthApp(config *config.ServiceConfig, redis *redis.Client, db *mongo.Dat
 
 	service := service.NewAuthService(redis, tracer)
 
-	accountRep := accountRepository.NewAccountRepository(service, db.Collection(accountCollection))
-	accountCase := accountUsecase.NewAccountUsecase(config, service, accountRep)
-	accountDelv := accountDelivery.NewAuthGRPCServer(service, accountCase)
+	accountRep := accountRepository.NewAccountRepository(service, db.Collection(accountCollection), db.Collection(outboxCollection))
+
+	err = accountRep.MigrateAccounts(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	err = accountRep.NormalizeEmails(context.Background(), accountModels.EmailForm(config.EmailProviderRules), func(email string) (string, error) {
+		return accountModels.NormalizeEmail(email, config.EmailProviderRules)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = accountRep.EnsureIndexes(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	outboxRep := eventRepository.NewOutboxRepository(service, db.Collection(outboxCollection))
+
+	err = outboxRep.EnsureIndexes(context.Background(), config.OutboxRetention)
+	if err != nil {
+		return nil, err
+	}
+
+	publisher := eventPublisher.NewStreamPublisher(service, redis, config.EventStream, config.EventStreamMaxLen)
+	eventCase := eventUsecase.NewEventUsecase(config, service, outboxRep, publisher)
+
+	sessionRep := sessionRepository.NewSessionRepository(service, redis, config.RefreshTokenTTL)
+
+	keyRep := tokenRepository.NewKeyRepository(service, db.Collection(keyCollection))
+	tokenCase, err := tokenUsecase.NewTokenUsecase(config, service, keyRep, accountRep, sessionRep)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tokenCase.RotateKeys(context.Background())
+	if err != nil {
+		return nil, err
+	}
 
-	grpcServ := grpc.NewServer()
+	sessionCase := sessionUsecase.NewSessionUsecase(config, service, sessionRep, tokenCase)
+
+	hasher, err := password.NewHasher(config)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := password.NewPolicy(config)
+	if err != nil {
+		return nil, err
+	}
+
+	mailer, err := mailer.NewMailer(config, service)
+	if err != nil {
+		return nil, err
+	}
+
+	accountCase := accountUsecase.NewAccountUsecase(config, service, accountRep, hasher, policy, mailer, tokenCase, sessionCase)
+	accountDelv := accountDelivery.NewAuthGRPCServer(service, accountCase, tokenCase, sessionCase)
+
+	grpcServ := grpc.NewServer(
+		grpc.UnaryInterceptor(accountDelivery.NewAdminInterceptor(config.AdminAPIKeys)),
+		grpc.StreamInterceptor(accountDelivery.NewAdminStreamInterceptor(config.AdminAPIKeys)),
+	)
 	api.RegisterAuthServer(grpcServ, accountDelv)
+	api.RegisterAdminServiceServer(grpcServ, accountDelivery.NewAdminGRPCServer(service, accountCase))
+
+	mux := http.NewServeMux()
+	mux.Handle(tokenDelivery.JWKSPath, tokenDelivery.NewJWKSHandler(config, service, tokenCase))
+
+	httpServ := &http.Server{
+		Addr:    config.HTTPAddr,
+		Handler: mux,
+	}
 
 	return &authApp{
 		tracerCloser: closer,
 		grpcServer:   grpcServ,
+		httpServer:   httpServ,
 		config:       config,
+		tokenCase:    tokenCase,
+		eventCase:    eventCase,
+		accountCase:  accountCase,
+		stop:         make(chan struct{}),
 	}, nil
 }
 
@@ -62,11 +162,76 @@ func (app *authApp) Run() error {
 	if err != nil {
 		return err
 	}
+
+	go app.rotateKeys()
+	go app.relayEvents()
+	go app.purgeAccounts()
+
+	go func() {
+		err := app.httpServer.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Println(err)
+		}
+	}()
+
 	err = app.grpcServer.Serve(lis)
 	return err
 }
 
+func (app *authApp) rotateKeys() {
+	ticker := time.NewTicker(app.config.KeyRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := app.tokenCase.RotateKeys(context.Background())
+			if err != nil {
+				log.Println(err)
+			}
+		case <-app.stop:
+			return
+		}
+	}
+}
+
+func (app *authApp) relayEvents() {
+	ticker := time.NewTicker(app.config.OutboxPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_, err := app.eventCase.RelayEvents(context.Background())
+			if err != nil {
+				log.Println(err)
+			}
+		case <-app.stop:
+			return
+		}
+	}
+}
+
+func (app *authApp) purgeAccounts() {
+	ticker := time.NewTicker(app.config.AccountPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_, err := app.accountCase.PurgeDeletedAccounts(context.Background())
+			if err != nil {
+				log.Println(err)
+			}
+		case <-app.stop:
+			return
+		}
+	}
+}
+
 func (app *authApp) Shutdown() {
+	close(app.stop)
+	app.httpServer.Shutdown(context.Background())
 	app.grpcServer.GracefulStop()
 	app.tracerCloser.Close()
 }
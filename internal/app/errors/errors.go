@@ -3,6 +3,7 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 type DeliveryError struct {
@@ -20,6 +21,8 @@ func (e *DeliveryError) Error() string {
 
 var (
 	ErrBrokenContext = errors.New("broken context")
+	ErrMissingAPIKey = errors.New("missing api key")
+	ErrInvalidAPIKey = errors.New("invalid api key")
 )
 
 type UsecaseError struct {
@@ -36,13 +39,68 @@ func (e *UsecaseError) Error() string {
 }
 
 var (
-	ErrWrongPassword    = errors.New("wrong password")
-	ErrUnableToStoreKey = errors.New("unable to store key")
-	ErrInvalid2FACode   = errors.New("invalid 2FA code")
-	Err2FADisabled      = errors.New("2fa disabled")
-	ErrInactiveAccount  = errors.New("inactive account")
+	ErrWrongPassword      = errors.New("wrong password")
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrUnableToStoreKey   = errors.New("unable to store key")
+	ErrInvalid2FACode     = errors.New("invalid 2FA code")
+	Err2FADisabled        = errors.New("2fa disabled")
+
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrNoSigningKey         = errors.New("no signing key")
+	ErrInvalidEncryptionKey = errors.New("invalid signing key encryption key")
+	ErrUnknownEncryptionKey = errors.New("unknown signing key encryption key")
+
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reused")
+	ErrRefreshTokenRevoked = errors.New("refresh token revoked")
+
+	ErrInvalidToken  = errors.New("invalid token")
+	ErrTokenExpired  = errors.New("token expired")
+	ErrTokenRevoked  = errors.New("token revoked")
+	ErrKeyNotFound   = errors.New("signing key not found")
+	ErrBatchTooLarge = errors.New("batch too large")
+
+	ErrInvalidChallenge = errors.New("invalid challenge")
+
+	ErrSessionNotFound = errors.New("session not found")
+	ErrSessionRevoked  = errors.New("session revoked")
+
+	ErrInvalidResetToken        = errors.New("invalid password reset token")
+	ErrInvalidVerificationToken = errors.New("invalid email verification token")
+	ErrTooManyRequests          = errors.New("too many requests")
+	ErrInvalidEmailChangeToken  = errors.New("invalid email change token")
+	ErrEmailTaken               = errors.New("email already taken")
+	ErrInvalidEmail             = errors.New("invalid email")
+	ErrAccountNotSpecified      = errors.New("account id or email required")
+	ErrInvalidPasswordHash      = errors.New("unrecognized password hash")
+	ErrInvalid2FASecret         = errors.New("invalid 2FA secret")
+	ErrInvalidStatus            = errors.New("invalid account status")
+
+	ErrAccountPending      = errors.New("account pending email verification")
+	ErrAccountSuspended    = errors.New("account suspended")
+	ErrAccountLocked       = errors.New("account locked")
+	ErrAccountDeleted      = errors.New("account scheduled for deletion")
+	ErrAccountNotSuspended = errors.New("account not suspended")
+	ErrDeletionNotPending  = errors.New("account deletion not pending")
 )
 
+type PolicyViolation struct {
+	Rule        string
+	Description string
+}
+
+type PolicyError struct {
+	Violations []PolicyViolation
+}
+
+func (e *PolicyError) Error() string {
+	rules := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		rules = append(rules, v.Rule)
+	}
+	return fmt.Sprintf("password policy violated: %s", strings.Join(rules, ", "))
+}
+
 type RepositoryError struct {
 	Impl string
 	Err  error
@@ -57,7 +115,8 @@ func (e *RepositoryError) Error() string {
 }
 
 var (
-	ErrNotFound = errors.New("not found")
+	ErrNotFound      = errors.New("not found")
+	ErrAlreadyExists = errors.New("already exists")
 )
 
 type ServiceError struct {
@@ -65,10 +124,10 @@ type ServiceError struct {
 	Err    error
 }
 
-func (e ServiceError) Error() string {
+func (e *ServiceError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Method, e.Err)
 }
 
-func (e ServiceError) Unwrap() error {
+func (e *ServiceError) Unwrap() error {
 	return e.Err
 }
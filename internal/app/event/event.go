@@ -0,0 +1,64 @@
+package event
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+const (
+	TypeAccountRegistered      = "account.registered"
+	TypeAccountActivated       = "account.activated"
+	TypeAccountPasswordChanged = "account.password_changed"
+	Type2FAEnabled             = "account.2fa_enabled"
+	Type2FADisabled            = "account.2fa_disabled"
+	TypeDeletionRequested      = "account.deletion_requested"
+	TypeDeletionCancelled      = "account.deletion_cancelled"
+	TypeAccountDeleted         = "account.deleted"
+	TypeAccountSuspended       = "account.suspended"
+	TypeAccountLocked          = "account.locked"
+	TypeAccountUnsuspended     = "account.unsuspended"
+	TypeEmailChanged           = "account.email_changed"
+	TypeEmailChangeReverted    = "account.email_change_reverted"
+	TypeAccountImported        = "account.imported"
+)
+
+// Event is an outbox record. It's written in the same transaction as the
+// account change it describes and published later by the relay.
+type Event struct {
+	ID          string    `json:"id"`
+	Type        string    `json:"type"`
+	AccountID   string    `json:"account_id"`
+	Payload     string    `json:"payload"`
+	CreatedAt   time.Time `json:"created_at"`
+	Published   bool      `json:"published"`
+	PublishedAt time.Time `json:"published_at"`
+	Attempts    int       `json:"attempts"`
+	LastError   string    `json:"last_error,omitempty"`
+}
+
+type AccountPayload struct {
+	Email string `json:"email"`
+}
+
+func NewEvent(eventType, accountID string, payload interface{}) (*Event, error) {
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		ID:        hex.EncodeToString(id),
+		Type:      eventType,
+		AccountID: accountID,
+		Payload:   string(data),
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,11 @@
+package publisher
+
+import (
+	"context"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/event"
+)
+
+type Publisher interface {
+	Publish(ctx context.Context, event *models.Event) error
+}
@@ -0,0 +1,47 @@
+package publisher
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/event"
+)
+
+// streamPublisher appends events to a Redis stream. Delivery is
+// at-least-once, so consumers should deduplicate on the "id" field.
+type streamPublisher struct {
+	service     service.AuthService
+	redisClient *redis.Client
+	stream      string
+	maxLen      int64
+}
+
+func NewStreamPublisher(service service.AuthService, redisClient *redis.Client, stream string, maxLen int64) Publisher {
+	return &streamPublisher{
+		service:     service,
+		redisClient: redisClient,
+		stream:      stream,
+		maxLen:      maxLen,
+	}
+}
+
+func (p *streamPublisher) Publish(ctx context.Context, event *models.Event) error {
+	span := p.service.StartSpan(ctx, "Publish")
+	defer span.Finish()
+
+	return p.redisClient.XAdd(&redis.XAddArgs{
+		Stream:       p.stream,
+		MaxLenApprox: p.maxLen,
+		Values: map[string]interface{}{
+			"id":         event.ID,
+			"type":       event.Type,
+			"account_id": event.AccountID,
+			"payload":    event.Payload,
+			"created_at": event.CreatedAt.Format(time.RFC3339Nano),
+		},
+	}).Err()
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/barugoo/oscillo-auth/internal/app/errors"
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/event"
+)
+
+const (
+	mongoDB = "mongoDB"
+
+	pendingIndex   = "pending"
+	idIndex        = "id_unique"
+	retentionIndex = "published_ttl"
+)
+
+type outboxRepository struct {
+	service    service.AuthService
+	collection *mongo.Collection
+}
+
+func NewOutboxRepository(service service.AuthService, collection *mongo.Collection) OutboxRepository {
+	return &outboxRepository{
+		service:    service,
+		collection: collection,
+	}
+}
+
+func (h *outboxRepository) EnsureIndexes(ctx context.Context, retention time.Duration) error {
+	span := h.service.StartSpan(ctx, "EnsureIndexes")
+	defer span.Finish()
+
+	err := h.ensureIndexes(retention)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return err
+}
+
+// ensureIndexes is safe to call on every start. The TTL index only covers
+// published events, pending ones have a zero publishedat that would expire
+// right away. Changing retention needs the old TTL index dropped first.
+func (h *outboxRepository) ensureIndexes(retention time.Duration) error {
+	_, err := h.collection.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "published", Value: 1}, {Key: "createdat", Value: 1}},
+			Options: options.Index().SetName(pendingIndex),
+		},
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetName(idIndex).SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "publishedat", Value: 1}},
+			Options: options.Index().
+				SetName(retentionIndex).
+				SetExpireAfterSeconds(int32(retention / time.Second)).
+				SetPartialFilterExpression(bson.D{{Key: "published", Value: true}}),
+		},
+	})
+	return err
+}
+
+func (h *outboxRepository) GetPendingEvents(ctx context.Context, limit int) ([]*models.Event, error) {
+	span := h.service.StartSpan(ctx, "GetPendingEvents")
+	defer span.Finish()
+
+	events, err := h.getPendingEvents(limit)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return events, err
+}
+
+func (h *outboxRepository) getPendingEvents(limit int) ([]*models.Event, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdat", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := h.collection.Find(context.TODO(), bson.D{{Key: "published", Value: false}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.TODO())
+
+	var events []*models.Event
+	err = cursor.All(context.TODO(), &events)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (h *outboxRepository) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	span := h.service.StartSpan(ctx, "MarkPublished")
+	defer span.Finish()
+
+	err := h.markPublished(id, at)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return err
+}
+
+func (h *outboxRepository) markPublished(id string, at time.Time) error {
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "published", Value: true},
+			{Key: "publishedat", Value: at},
+		}},
+		{Key: "$inc", Value: bson.D{{Key: "attempts", Value: 1}}},
+	}
+
+	_, err := h.collection.UpdateOne(context.TODO(), bson.D{{Key: "id", Value: id}}, update)
+	return err
+}
+
+func (h *outboxRepository) MarkFailed(ctx context.Context, id string, reason string) error {
+	span := h.service.StartSpan(ctx, "MarkFailed")
+	defer span.Finish()
+
+	err := h.markFailed(id, reason)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return err
+}
+
+func (h *outboxRepository) markFailed(id string, reason string) error {
+	update := bson.D{
+		{Key: "$set", Value: bson.D{{Key: "lasterror", Value: reason}}},
+		{Key: "$inc", Value: bson.D{{Key: "attempts", Value: 1}}},
+	}
+
+	_, err := h.collection.UpdateOne(context.TODO(), bson.D{{Key: "id", Value: id}}, update)
+	return err
+}
+
+func (h *outboxRepository) wrapError(err error) error {
+
+	switch err {
+	case mongo.ErrNoDocuments:
+		err = errors.ErrNotFound
+	default:
+		err = fmt.Errorf("%v", err)
+	}
+	return &errors.RepositoryError{
+		Impl: mongoDB,
+		Err:  err,
+	}
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/event"
+)
+
+type OutboxRepository interface {
+	// EnsureIndexes creates the indexes the relay queries by. Published
+	// events are removed retention after they went out.
+	EnsureIndexes(ctx context.Context, retention time.Duration) error
+	GetPendingEvents(ctx context.Context, limit int) ([]*models.Event, error)
+	MarkPublished(ctx context.Context, id string, at time.Time) error
+	MarkFailed(ctx context.Context, id string, reason string) error
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/barugoo/oscillo-auth/config"
+
+	"github.com/barugoo/oscillo-auth/internal/app/errors"
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+
+	"github.com/barugoo/oscillo-auth/internal/app/event/publisher"
+	"github.com/barugoo/oscillo-auth/internal/app/event/repository"
+)
+
+type EventUsecase interface {
+	RelayEvents(ctx context.Context) (int, error)
+}
+
+const (
+	usecaseMethodTemplate = "%s/usecase"
+
+	relayLockKey = "outbox_relay_lock"
+)
+
+type eventUsecase struct {
+	service    service.AuthService
+	config     *config.ServiceConfig
+	repository repository.OutboxRepository
+	publisher  publisher.Publisher
+}
+
+func NewEventUsecase(config *config.ServiceConfig, service service.AuthService, repository repository.OutboxRepository, publisher publisher.Publisher) EventUsecase {
+	return &eventUsecase{
+		config:     config,
+		service:    service,
+		repository: repository,
+		publisher:  publisher,
+	}
+}
+
+func (uc *eventUsecase) RelayEvents(ctx context.Context) (int, error) {
+	methodName := uc.getMethodFromContext(ctx, "RelayEvents")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	n, err := uc.relayEvents(ctx)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return n, err
+}
+
+// relayEvents publishes pending outbox events oldest first. An event is only
+// marked published after the publisher accepted it, so a crash in between
+// means it goes out again: delivery is at-least-once. The batch stops at the
+// first failure to keep events of an account in order.
+func (uc *eventUsecase) relayEvents(ctx context.Context) (int, error) {
+	// only one instance relays at a time. The lock holds a token of its own,
+	// if the batch outlives the TTL and another instance takes the lock, it's
+	// left to that instance.
+	owner, err := lockOwner()
+	if err != nil {
+		return 0, err
+	}
+	ok, err := uc.service.SetKVNX(ctx, relayLockKey, owner, uc.config.OutboxLockTTL)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+	defer uc.service.DelKVIfEqual(ctx, relayLockKey, owner)
+
+	events, err := uc.repository.GetPendingEvents(ctx, uc.config.OutboxBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, event := range events {
+		err = uc.publisher.Publish(ctx, event)
+		if err != nil {
+			markErr := uc.repository.MarkFailed(ctx, event.ID, err.Error())
+			if markErr != nil {
+				return n, markErr
+			}
+			return n, err
+		}
+
+		err = uc.repository.MarkPublished(ctx, event.ID, time.Now().UTC())
+		if err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+func lockOwner() (string, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (uc *eventUsecase) wrapError(err error, method string) error {
+	return &errors.UsecaseError{
+		Method: method,
+		Err:    err,
+	}
+}
+
+func (uc *eventUsecase) getMethodFromContext(ctx context.Context, name string) string {
+	if method, ok := ctx.Value("method").(string); ok {
+		name = method
+	}
+	return fmt.Sprintf(usecaseMethodTemplate, name)
+}
@@ -0,0 +1,54 @@
+package mailer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+)
+
+// fileMailer drops every message as an .eml file into a directory instead of
+// delivering it, for local development and tests.
+type fileMailer struct {
+	service service.AuthService
+	dir     string
+	from    string
+}
+
+func NewFileMailer(service service.AuthService, dir, from string) (Mailer, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileMailer{
+		service: service,
+		dir:     dir,
+		from:    from,
+	}, nil
+}
+
+func (m *fileMailer) Send(ctx context.Context, msg *Message) error {
+	span := m.service.StartSpan(ctx, "Send")
+	defer span.Finish()
+
+	body, err := encode(msg, m.from)
+	if err != nil {
+		return err
+	}
+
+	suffix := make([]byte, 4)
+	_, err = rand.Read(suffix)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), hex.EncodeToString(suffix))
+	return ioutil.WriteFile(filepath.Join(m.dir, name), body, 0600)
+}
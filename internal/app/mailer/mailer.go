@@ -0,0 +1,72 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/barugoo/oscillo-auth/config"
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+)
+
+const (
+	DriverLog  = "log"
+	DriverFile = "file"
+	DriverSMTP = "smtp"
+)
+
+var (
+	ErrNoDriver      = errors.New("mailer driver not set")
+	ErrUnknownDriver = errors.New("unknown mailer driver")
+)
+
+type Message struct {
+	To string
+	// Template is the name the message was rendered from, if any.
+	Template string
+	Subject  string
+	Text     string
+	HTML     string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewMailer has no default driver, mail carries secret links and must not
+// end up in the log of a deployment that forgot to set one.
+func NewMailer(config *config.ServiceConfig, service service.AuthService) (Mailer, error) {
+	switch config.MailerDriver {
+	case "":
+		return nil, ErrNoDriver
+	case DriverLog:
+		return NewLogMailer(service), nil
+	case DriverFile:
+		return NewFileMailer(service, config.MailOutboxDir, config.MailFrom)
+	case DriverSMTP:
+		return NewSMTPMailer(service, config.SMTPAddr, config.SMTPUsername, config.SMTPPassword, config.MailFrom)
+	default:
+		return nil, ErrUnknownDriver
+	}
+}
+
+// logMailer only notes sent messages in the service log, without their
+// contents. It's meant for setups where no mail delivery is needed, use the
+// file driver to read the messages.
+type logMailer struct {
+	service service.AuthService
+}
+
+func NewLogMailer(service service.AuthService) Mailer {
+	return &logMailer{
+		service: service,
+	}
+}
+
+func (m *logMailer) Send(ctx context.Context, msg *Message) error {
+	span := m.service.StartSpan(ctx, "Send")
+	defer span.Finish()
+
+	log.Printf("mail to %s: template %s", msg.To, msg.Template)
+	return nil
+}
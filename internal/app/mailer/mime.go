@@ -0,0 +1,86 @@
+package mailer
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// encode renders msg as an RFC 5322 message with a multipart/alternative
+// body, so clients can pick the plain text or the HTML part.
+func encode(msg *Message, from string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	id, err := messageID(from)
+	if err != nil {
+		return nil, err
+	}
+
+	body := multipart.NewWriter(&buf)
+	headers := []string{
+		"From: " + from,
+		"To: " + msg.To,
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"Message-ID: " + id,
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/alternative; boundary=" + body.Boundary(),
+	}
+	buf.WriteString(strings.Join(headers, "\r\n") + "\r\n\r\n")
+
+	err = writePart(body, "text/plain", msg.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.HTML != "" {
+		err = writePart(body, "text/html", msg.HTML)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = body.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writePart(body *multipart.Writer, contentType, content string) error {
+	part, err := body.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType + "; charset=utf-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	w := quotedprintable.NewWriter(part)
+	_, err = w.Write([]byte(content))
+	if err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func messageID(from string) (string, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	domain := "localhost"
+	if i := strings.LastIndex(from, "@"); i >= 0 {
+		domain = strings.Trim(from[i+1:], "> ")
+	}
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domain), nil
+}
@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"context"
+	"net"
+	"net/mail"
+	"net/smtp"
+
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+)
+
+type smtpMailer struct {
+	service service.AuthService
+	addr    string
+	auth    smtp.Auth
+	from    string
+	sender  string
+}
+
+func NewSMTPMailer(service service.AuthService, addr, username, password, from string) (Mailer, error) {
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &smtpMailer{
+		service: service,
+		addr:    addr,
+		from:    from,
+		sender:  sender.Address,
+	}
+
+	if username != "" {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m, nil
+}
+
+func (m *smtpMailer) Send(ctx context.Context, msg *Message) error {
+	span := m.service.StartSpan(ctx, "Send")
+	defer span.Finish()
+
+	body, err := encode(msg, m.from)
+	if err != nil {
+		return err
+	}
+	return smtp.SendMail(m.addr, m.auth, m.sender, []string{msg.To}, body)
+}
@@ -0,0 +1,106 @@
+package mailer
+
+import (
+	"bytes"
+	"errors"
+	htmlTemplate "html/template"
+	"strings"
+	textTemplate "text/template"
+	"time"
+)
+
+const (
+	TemplateEmailVerification = "email_verification"
+	TemplatePasswordReset     = "password_reset"
+	TemplatePasswordChanged   = "password_changed"
+	TemplateEmailChange       = "email_change"
+	TemplateEmailChanged      = "email_changed"
+
+	DefaultLocale = "en"
+)
+
+var (
+	ErrUnknownTemplate = errors.New("unknown mail template")
+)
+
+// Data is what templates get rendered with. Fields a template doesn't use
+// can be left empty.
+type Data struct {
+	Email     string
+	NewEmail  string
+	Link      string
+	ExpiresAt time.Time
+}
+
+type mailTemplate struct {
+	subject *textTemplate.Template
+	text    *textTemplate.Template
+	html    *htmlTemplate.Template
+}
+
+var templates = make(map[string]map[string]*mailTemplate)
+
+func init() {
+	for name, locales := range templateSources {
+		templates[name] = make(map[string]*mailTemplate, len(locales))
+		for locale, src := range locales {
+			templates[name][locale] = &mailTemplate{
+				subject: textTemplate.Must(textTemplate.New("subject").Parse(src.subject)),
+				text:    textTemplate.Must(textTemplate.New("text").Parse(src.text)),
+				html:    htmlTemplate.Must(htmlTemplate.New("html").Parse(src.html)),
+			}
+		}
+	}
+}
+
+// Render builds the message for the named template in the closest locale
+// available: "pt-BR" falls back to "pt" and then to DefaultLocale.
+func Render(name, locale, to string, data *Data) (*Message, error) {
+	locales, ok := templates[name]
+	if !ok {
+		return nil, ErrUnknownTemplate
+	}
+
+	tmpl := lookupLocale(locales, locale)
+	if tmpl == nil {
+		return nil, ErrUnknownTemplate
+	}
+
+	var subject, text, html bytes.Buffer
+
+	err := tmpl.subject.Execute(&subject, data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tmpl.text.Execute(&text, data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tmpl.html.Execute(&html, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		To:       to,
+		Template: name,
+		Subject:  strings.TrimSpace(subject.String()),
+		Text:     text.String(),
+		HTML:     html.String(),
+	}, nil
+}
+
+func lookupLocale(locales map[string]*mailTemplate, locale string) *mailTemplate {
+	locale = strings.ToLower(strings.Replace(locale, "_", "-", -1))
+	if tmpl, ok := locales[locale]; ok {
+		return tmpl
+	}
+	if i := strings.IndexByte(locale, '-'); i >= 0 {
+		if tmpl, ok := locales[locale[:i]]; ok {
+			return tmpl
+		}
+	}
+	return locales[DefaultLocale]
+}
@@ -0,0 +1,146 @@
+package mailer
+
+type templateSource struct {
+	subject string
+	text    string
+	html    string
+}
+
+const expiresAtLayout = `{{.ExpiresAt.UTC.Format "2006-01-02 15:04 MST"}}`
+
+var templateSources = map[string]map[string]templateSource{
+	TemplateEmailVerification: {
+		"en": {
+			subject: "Confirm your email",
+			text: `Thanks for signing up!
+
+Use the following to confirm your email address: {{.Link}}
+
+It's valid until ` + expiresAtLayout + `. If you didn't create an account, just ignore this email.
+`,
+			html: `<p>Thanks for signing up!</p>
+<p>Use the following to confirm your email address: <a href="{{.Link}}">{{.Link}}</a></p>
+<p>It's valid until ` + expiresAtLayout + `. If you didn't create an account, just ignore this email.</p>
+`,
+		},
+		"ru": {
+			subject: "Подтвердите email",
+			text: `Спасибо за регистрацию!
+
+Чтобы подтвердить адрес электронной почты, используйте: {{.Link}}
+
+Ссылка действительна до ` + expiresAtLayout + `. Если вы не создавали аккаунт, просто проигнорируйте это письмо.
+`,
+			html: `<p>Спасибо за регистрацию!</p>
+<p>Чтобы подтвердить адрес электронной почты, используйте: <a href="{{.Link}}">{{.Link}}</a></p>
+<p>Ссылка действительна до ` + expiresAtLayout + `. Если вы не создавали аккаунт, просто проигнорируйте это письмо.</p>
+`,
+		},
+	},
+	TemplatePasswordReset: {
+		"en": {
+			subject: "Reset your password",
+			text: `Someone asked to reset the password of your account.
+
+Use the following to choose a new one: {{.Link}}
+
+It's valid until ` + expiresAtLayout + `. If it wasn't you, just ignore this email.
+`,
+			html: `<p>Someone asked to reset the password of your account.</p>
+<p>Use the following to choose a new one: <a href="{{.Link}}">{{.Link}}</a></p>
+<p>It's valid until ` + expiresAtLayout + `. If it wasn't you, just ignore this email.</p>
+`,
+		},
+		"ru": {
+			subject: "Сброс пароля",
+			text: `Кто-то запросил сброс пароля для вашего аккаунта.
+
+Чтобы задать новый пароль, используйте: {{.Link}}
+
+Ссылка действительна до ` + expiresAtLayout + `. Если это были не вы, просто проигнорируйте это письмо.
+`,
+			html: `<p>Кто-то запросил сброс пароля для вашего аккаунта.</p>
+<p>Чтобы задать новый пароль, используйте: <a href="{{.Link}}">{{.Link}}</a></p>
+<p>Ссылка действительна до ` + expiresAtLayout + `. Если это были не вы, просто проигнорируйте это письмо.</p>
+`,
+		},
+	},
+	TemplatePasswordChanged: {
+		"en": {
+			subject: "Your password was changed",
+			text: `The password of your account {{.Email}} was just changed and your other sessions were signed out.
+
+If it wasn't you, reset your password right away.
+`,
+			html: `<p>The password of your account {{.Email}} was just changed and your other sessions were signed out.</p>
+<p>If it wasn't you, reset your password right away.</p>
+`,
+		},
+		"ru": {
+			subject: "Пароль изменён",
+			text: `Пароль вашего аккаунта {{.Email}} был изменён, остальные сессии завершены.
+
+Если это были не вы, немедленно сбросьте пароль.
+`,
+			html: `<p>Пароль вашего аккаунта {{.Email}} был изменён, остальные сессии завершены.</p>
+<p>Если это были не вы, немедленно сбросьте пароль.</p>
+`,
+		},
+	},
+	TemplateEmailChange: {
+		"en": {
+			subject: "Confirm your new email",
+			text: `Someone asked to change the email of the account {{.Email}} to this address.
+
+Use the following to confirm it: {{.Link}}
+
+It's valid until ` + expiresAtLayout + `. If it wasn't you, just ignore this email.
+`,
+			html: `<p>Someone asked to change the email of the account {{.Email}} to this address.</p>
+<p>Use the following to confirm it: <a href="{{.Link}}">{{.Link}}</a></p>
+<p>It's valid until ` + expiresAtLayout + `. If it wasn't you, just ignore this email.</p>
+`,
+		},
+		"ru": {
+			subject: "Подтвердите новый email",
+			text: `Кто-то запросил смену email аккаунта {{.Email}} на этот адрес.
+
+Чтобы подтвердить, используйте: {{.Link}}
+
+Ссылка действительна до ` + expiresAtLayout + `. Если это были не вы, просто проигнорируйте это письмо.
+`,
+			html: `<p>Кто-то запросил смену email аккаунта {{.Email}} на этот адрес.</p>
+<p>Чтобы подтвердить, используйте: <a href="{{.Link}}">{{.Link}}</a></p>
+<p>Ссылка действительна до ` + expiresAtLayout + `. Если это были не вы, просто проигнорируйте это письмо.</p>
+`,
+		},
+	},
+	TemplateEmailChanged: {
+		"en": {
+			subject: "Your email was changed",
+			text: `The email of your account {{.Email}} was changed to {{.NewEmail}}.
+
+If it wasn't you, use the following to undo the change: {{.Link}}
+
+It's valid until ` + expiresAtLayout + `.
+`,
+			html: `<p>The email of your account {{.Email}} was changed to {{.NewEmail}}.</p>
+<p>If it wasn't you, use the following to undo the change: <a href="{{.Link}}">{{.Link}}</a></p>
+<p>It's valid until ` + expiresAtLayout + `.</p>
+`,
+		},
+		"ru": {
+			subject: "Email изменён",
+			text: `Email вашего аккаунта {{.Email}} был изменён на {{.NewEmail}}.
+
+Если это были не вы, отмените изменение: {{.Link}}
+
+Ссылка действительна до ` + expiresAtLayout + `.
+`,
+			html: `<p>Email вашего аккаунта {{.Email}} был изменён на {{.NewEmail}}.</p>
+<p>Если это были не вы, отмените изменение: <a href="{{.Link}}">{{.Link}}</a></p>
+<p>Ссылка действительна до ` + expiresAtLayout + `.</p>
+`,
+		},
+	},
+}
@@ -0,0 +1,136 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2SaltLen = 16
+	argon2KeyLen  = 32
+
+	argon2MinSaltLen = 8
+	argon2MinKeyLen  = 16
+	argon2MaxKeyLen  = 64
+
+	argon2Prefix = "$argon2id$"
+)
+
+type argon2idHasher struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+	saltLen uint32
+	keyLen  uint32
+
+	maxTime    uint32
+	maxMemory  uint32
+	maxThreads uint8
+}
+
+type argon2Params struct {
+	version int
+	time    uint32
+	memory  uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+func (h *argon2idHasher) Recognizes(encoded string) bool {
+	return strings.HasPrefix(encoded, argon2Prefix)
+}
+
+func (h *argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.saltLen)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.time, h.memory, h.threads, h.keyLen)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2Prefix,
+		argon2.Version,
+		h.memory,
+		h.time,
+		h.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h *argon2idHasher) Verify(password, encoded string) (bool, error) {
+	params, err := h.decode(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	key := argon2.IDKey([]byte(password), params.salt, params.time, params.memory, params.threads, uint32(len(params.key)))
+	return subtle.ConstantTimeCompare(key, params.key) == 1, nil
+}
+
+func (h *argon2idHasher) NeedsRehash(encoded string) bool {
+	params, err := h.decode(encoded)
+	if err != nil {
+		return true
+	}
+	return params.version != argon2.Version ||
+		params.time != h.time ||
+		params.memory != h.memory ||
+		params.threads != h.threads ||
+		uint32(len(params.salt)) != h.saltLen ||
+		uint32(len(params.key)) != h.keyLen
+}
+
+func (h *argon2idHasher) Validate(encoded string) error {
+	params, err := h.decode(encoded)
+	if err != nil {
+		return err
+	}
+	if params.version != argon2.Version ||
+		params.time < 1 || params.time > h.maxTime ||
+		params.memory < 8*uint32(params.threads) || params.memory > h.maxMemory ||
+		params.threads < 1 || params.threads > h.maxThreads ||
+		len(params.salt) < argon2MinSaltLen ||
+		len(params.key) < argon2MinKeyLen || len(params.key) > argon2MaxKeyLen {
+		return ErrHashOutOfBounds
+	}
+	return nil
+}
+
+func (h *argon2idHasher) decode(encoded string) (*argon2Params, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != Argon2id {
+		return nil, ErrMalformedHash
+	}
+
+	params := &argon2Params{}
+
+	_, err := fmt.Sscanf(parts[2], "v=%d", &params.version)
+	if err != nil {
+		return nil, ErrMalformedHash
+	}
+
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads)
+	if err != nil {
+		return nil, ErrMalformedHash
+	}
+
+	params.salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, ErrMalformedHash
+	}
+
+	params.key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(params.key) == 0 {
+		return nil, ErrMalformedHash
+	}
+	return params, nil
+}
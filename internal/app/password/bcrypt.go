@@ -0,0 +1,75 @@
+package password
+
+import (
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	bcryptHashLen = 60
+	// bcryptAlphabet is the base64 variant bcrypt encodes salt and hash in.
+	bcryptAlphabet = "./ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+type bcryptHasher struct {
+	cost    int
+	maxCost int
+}
+
+func (h *bcryptHasher) Recognizes(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") ||
+		strings.HasPrefix(encoded, "$2b$") ||
+		strings.HasPrefix(encoded, "$2y$")
+}
+
+func (h *bcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func (h *bcryptHasher) Verify(password, encoded string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (h *bcryptHasher) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return true
+	}
+	return cost != h.cost
+}
+
+// Validate checks the whole hash, bcrypt.Cost only reads the prefix.
+func (h *bcryptHasher) Validate(encoded string) error {
+	if !h.Recognizes(encoded) || len(encoded) != bcryptHashLen {
+		return ErrMalformedHash
+	}
+
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return ErrMalformedHash
+	}
+
+	// "$2b$12$" followed by the encoded salt and hash
+	for _, c := range encoded[7:] {
+		if !strings.ContainsRune(bcryptAlphabet, c) {
+			return ErrMalformedHash
+		}
+	}
+
+	if cost < bcrypt.MinCost || cost > h.maxCost {
+		return ErrHashOutOfBounds
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package password
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/barugoo/oscillo-auth/config"
+)
+
+const (
+	Argon2id = "argon2id"
+	Bcrypt   = "bcrypt"
+)
+
+var (
+	ErrUnknownAlgorithm = errors.New("unknown password hash algorithm")
+	ErrMalformedHash    = errors.New("malformed password hash")
+	ErrHashOutOfBounds  = errors.New("password hash parameters out of bounds")
+)
+
+type Hasher interface {
+	Hash(password string) (string, error)
+	Verify(password, encoded string) (bool, error)
+	NeedsRehash(encoded string) bool
+	// Recognizes reports whether encoded looks like a hash Verify can check,
+	// judging by its prefix.
+	Recognizes(encoded string) bool
+	// Validate fully decodes encoded and checks its parameters are within
+	// the configured bounds. Hashes brought along from another system must
+	// pass it before they're stored.
+	Validate(encoded string) error
+}
+
+// policyHasher hashes with the configured algorithm but still verifies
+// hashes produced by any known one, so stored hashes can be upgraded on login.
+type policyHasher struct {
+	current    Hasher
+	algorithms []Hasher
+}
+
+func NewHasher(config *config.ServiceConfig) (Hasher, error) {
+	argon := &argon2idHasher{
+		time:       config.Argon2Time,
+		memory:     config.Argon2Memory,
+		threads:    config.Argon2Threads,
+		saltLen:    argon2SaltLen,
+		keyLen:     argon2KeyLen,
+		maxTime:    config.Argon2MaxTime,
+		maxMemory:  config.Argon2MaxMemory,
+		maxThreads: config.Argon2MaxThreads,
+	}
+	bcrypt := &bcryptHasher{
+		cost:    config.BcryptCost,
+		maxCost: config.BcryptMaxCost,
+	}
+
+	var current Hasher
+	switch strings.ToLower(config.PasswordHashAlgorithm) {
+	case Argon2id:
+		current = argon
+	case Bcrypt:
+		current = bcrypt
+	default:
+		return nil, ErrUnknownAlgorithm
+	}
+
+	var hasher Hasher = &policyHasher{
+		current:    current,
+		algorithms: []Hasher{argon, bcrypt},
+	}
+
+	if len(config.PasswordPeppers) > 0 || config.PasswordPepperVersion != "" {
+		return newPepperedHasher(hasher, config.PasswordPeppers, config.PasswordPepperVersion)
+	}
+	return hasher, nil
+}
+
+func (h *policyHasher) Hash(password string) (string, error) {
+	return h.current.Hash(password)
+}
+
+func (h *policyHasher) Verify(password, encoded string) (bool, error) {
+	alg := h.algorithm(encoded)
+	if alg == nil {
+		return false, ErrUnknownAlgorithm
+	}
+	return alg.Verify(password, encoded)
+}
+
+func (h *policyHasher) NeedsRehash(encoded string) bool {
+	if !h.current.Recognizes(encoded) {
+		return true
+	}
+	return h.current.NeedsRehash(encoded)
+}
+
+func (h *policyHasher) Recognizes(encoded string) bool {
+	return h.algorithm(encoded) != nil
+}
+
+func (h *policyHasher) Validate(encoded string) error {
+	alg := h.algorithm(encoded)
+	if alg == nil {
+		return ErrUnknownAlgorithm
+	}
+	return alg.Validate(encoded)
+}
+
+func (h *policyHasher) algorithm(encoded string) Hasher {
+	for _, alg := range h.algorithms {
+		if alg.Recognizes(encoded) {
+			return alg
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/barugoo/oscillo-auth/config"
+)
+
+// testConfig keeps the work factors low, the tests hash a lot.
+func testConfig(algorithm string) *config.ServiceConfig {
+	return &config.ServiceConfig{
+		PasswordHashAlgorithm: algorithm,
+		Argon2Time:            1,
+		Argon2Memory:          1024,
+		Argon2Threads:         1,
+		BcryptCost:            4,
+		Argon2MaxTime:         4,
+		Argon2MaxMemory:       64 * 1024,
+		Argon2MaxThreads:      4,
+		BcryptMaxCost:         10,
+	}
+}
+
+func newTestHasher(t *testing.T, cfg *config.ServiceConfig) Hasher {
+	t.Helper()
+	h, err := NewHasher(cfg)
+	if err != nil {
+		t.Fatalf("NewHasher: %v", err)
+	}
+	return h
+}
+
+func hashWith(t *testing.T, cfg *config.ServiceConfig, password string) string {
+	t.Helper()
+	encoded, err := newTestHasher(t, cfg).Hash(password)
+	if err != nil {
+		t.Fatalf("Hash: %v", err)
+	}
+	return encoded
+}
+
+func TestHasherVerify(t *testing.T) {
+	for _, algorithm := range []string{Argon2id, Bcrypt} {
+		h := newTestHasher(t, testConfig(algorithm))
+
+		encoded, err := h.Hash("correct horse")
+		if err != nil {
+			t.Fatalf("%s: Hash: %v", algorithm, err)
+		}
+
+		tests := []struct {
+			password string
+			want     bool
+		}{
+			{"correct horse", true},
+			{"correct horse ", false},
+			{"Correct horse", false},
+			{"", false},
+		}
+		for _, tt := range tests {
+			got, err := h.Verify(tt.password, encoded)
+			if err != nil {
+				t.Errorf("%s: Verify(%q): %v", algorithm, tt.password, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%s: Verify(%q) = %v, want %v", algorithm, tt.password, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestHasherVerifyOtherAlgorithm(t *testing.T) {
+	bcryptHash := hashWith(t, testConfig(Bcrypt), "secret")
+	argonHash := hashWith(t, testConfig(Argon2id), "secret")
+
+	tests := []struct {
+		current string
+		encoded string
+	}{
+		{Argon2id, bcryptHash},
+		{Bcrypt, argonHash},
+	}
+	for _, tt := range tests {
+		h := newTestHasher(t, testConfig(tt.current))
+		ok, err := h.Verify("secret", tt.encoded)
+		if err != nil || !ok {
+			t.Errorf("%s hasher: Verify(%q) = %v, %v, want true", tt.current, tt.encoded, ok, err)
+		}
+	}
+}
+
+func TestHasherVerifyErrors(t *testing.T) {
+	h := newTestHasher(t, testConfig(Argon2id))
+
+	tests := []struct {
+		encoded string
+		wantErr error
+	}{
+		{"", ErrUnknownAlgorithm},
+		{"plaintext", ErrUnknownAlgorithm},
+		{"$md5$abc", ErrUnknownAlgorithm},
+		{"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA", ErrMalformedHash},
+		{"$argon2id$v=19$m=1024,t=1,p=1$!!$a2V5", ErrMalformedHash},
+		{"$argon2id$v=19$m=1024,t=1,p=1$c2FsdHNhbHQ$", ErrMalformedHash},
+	}
+	for _, tt := range tests {
+		_, err := h.Verify("secret", tt.encoded)
+		if err != tt.wantErr {
+			t.Errorf("Verify(%q) error = %v, want %v", tt.encoded, err, tt.wantErr)
+		}
+	}
+}
+
+func TestHasherNeedsRehash(t *testing.T) {
+	argonHash := hashWith(t, testConfig(Argon2id), "secret")
+	bcryptHash := hashWith(t, testConfig(Bcrypt), "secret")
+
+	stronger := testConfig(Argon2id)
+	stronger.Argon2Time = 2
+	strongerArgonHash := hashWith(t, stronger, "secret")
+
+	costlier := testConfig(Bcrypt)
+	costlier.BcryptCost = 5
+	costlierBcryptHash := hashWith(t, costlier, "secret")
+
+	tests := []struct {
+		name    string
+		current string
+		encoded string
+		want    bool
+	}{
+		{"argon2id current", Argon2id, argonHash, false},
+		{"argon2id other params", Argon2id, strongerArgonHash, true},
+		{"argon2id from bcrypt", Argon2id, bcryptHash, true},
+		{"bcrypt current", Bcrypt, bcryptHash, false},
+		{"bcrypt other cost", Bcrypt, costlierBcryptHash, true},
+		{"bcrypt from argon2id", Bcrypt, argonHash, true},
+		{"unknown", Argon2id, "plaintext", true},
+		{"malformed", Argon2id, "$argon2id$v=19$garbage", true},
+	}
+	for _, tt := range tests {
+		h := newTestHasher(t, testConfig(tt.current))
+		if got := h.NeedsRehash(tt.encoded); got != tt.want {
+			t.Errorf("%s: NeedsRehash() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestHasherValidate(t *testing.T) {
+	h := newTestHasher(t, testConfig(Argon2id))
+
+	argonHash := hashWith(t, testConfig(Argon2id), "secret")
+	bcryptHash := hashWith(t, testConfig(Bcrypt), "secret")
+
+	tests := []struct {
+		name    string
+		encoded string
+		wantErr error
+	}{
+		{"argon2id", argonHash, nil},
+		{"bcrypt", bcryptHash, nil},
+		{"unknown", "plaintext", ErrUnknownAlgorithm},
+		{"argon2id truncated", argonHash[:len(argonHash)-44], ErrMalformedHash},
+		{"argon2id old version", strings.Replace(argonHash, "v=19", "v=16", 1), ErrHashOutOfBounds},
+		{"argon2id memory", strings.Replace(argonHash, "m=1024", "m=4194304", 1), ErrHashOutOfBounds},
+		{"argon2id time", strings.Replace(argonHash, "t=1", "t=1000", 1), ErrHashOutOfBounds},
+		{"argon2id threads", strings.Replace(argonHash, "p=1", "p=64", 1), ErrHashOutOfBounds},
+		{"bcrypt cost", strings.Replace(bcryptHash, "$04$", "$31$", 1), ErrHashOutOfBounds},
+		{"bcrypt truncated", bcryptHash[:len(bcryptHash)-1], ErrMalformedHash},
+		{"bcrypt alphabet", bcryptHash[:len(bcryptHash)-1] + "!", ErrMalformedHash},
+	}
+	for _, tt := range tests {
+		if err := h.Validate(tt.encoded); err != tt.wantErr {
+			t.Errorf("%s: Validate(%q) = %v, want %v", tt.name, tt.encoded, err, tt.wantErr)
+		}
+	}
+}
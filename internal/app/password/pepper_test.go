@@ -0,0 +1,60 @@
+package password
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPepperedHasher(t *testing.T) {
+	plain := testConfig(Argon2id)
+	plainHash := hashWith(t, plain, "secret")
+
+	v1 := testConfig(Argon2id)
+	v1.PasswordPeppers = map[string]string{"v1": "first"}
+	v1.PasswordPepperVersion = "v1"
+	v1Hash := hashWith(t, v1, "secret")
+
+	if !strings.HasPrefix(v1Hash, "$pepper$v=v1$") {
+		t.Fatalf("Hash() = %q, want a v1 pepper prefix", v1Hash)
+	}
+
+	v2 := testConfig(Argon2id)
+	v2.PasswordPeppers = map[string]string{"v1": "first", "v2": "second"}
+	v2.PasswordPepperVersion = "v2"
+
+	wrongPepper := testConfig(Argon2id)
+	wrongPepper.PasswordPeppers = map[string]string{"v1": "other"}
+	wrongPepper.PasswordPepperVersion = "v1"
+
+	tests := []struct {
+		name       string
+		hasher     Hasher
+		encoded    string
+		want       bool
+		wantErr    error
+		wantRehash bool
+	}{
+		{"unpeppered hash", newTestHasher(t, v1), plainHash, true, nil, true},
+		{"current pepper", newTestHasher(t, v1), v1Hash, true, nil, false},
+		{"previous pepper", newTestHasher(t, v2), v1Hash, true, nil, true},
+		{"pepper changed", newTestHasher(t, wrongPepper), v1Hash, false, nil, false},
+		{"pepper dropped", newTestHasher(t, plain), v1Hash, false, ErrUnknownAlgorithm, true},
+	}
+	for _, tt := range tests {
+		got, err := tt.hasher.Verify("secret", tt.encoded)
+		if err != tt.wantErr || got != tt.want {
+			t.Errorf("%s: Verify() = %v, %v, want %v, %v", tt.name, got, err, tt.want, tt.wantErr)
+		}
+		if rehash := tt.hasher.NeedsRehash(tt.encoded); rehash != tt.wantRehash {
+			t.Errorf("%s: NeedsRehash() = %v, want %v", tt.name, rehash, tt.wantRehash)
+		}
+	}
+
+	unknown := strings.Replace(v1Hash, "v=v1", "v=v9", 1)
+	if _, err := newTestHasher(t, v2).Verify("secret", unknown); err != ErrUnknownPepper {
+		t.Errorf("Verify() with unknown pepper version error = %v, want %v", err, ErrUnknownPepper)
+	}
+	if err := newTestHasher(t, v2).Validate(unknown); err != ErrUnknownPepper {
+		t.Errorf("Validate() with unknown pepper version = %v, want %v", err, ErrUnknownPepper)
+	}
+}
@@ -0,0 +1,182 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/barugoo/oscillo-auth/config"
+	"github.com/barugoo/oscillo-auth/internal/app/errors"
+)
+
+const (
+	RuleMinLength       = "min_length"
+	RuleMaxLength       = "max_length"
+	RuleUpper           = "upper"
+	RuleLower           = "lower"
+	RuleDigit           = "digit"
+	RuleSymbol          = "symbol"
+	RuleBannedSubstring = "banned_substring"
+	RuleEmail           = "email"
+	RuleBreached        = "breached"
+	RuleHistory         = "history"
+)
+
+// email local-parts shorter than this are too common to be worth banning.
+const minEmailPartLen = 3
+
+type Policy interface {
+	// Check returns an *errors.PolicyError listing every rule the password
+	// breaks, or nil if it's acceptable for the given account email.
+	Check(password, email string) error
+}
+
+type policy struct {
+	config   *config.ServiceConfig
+	banned   []string
+	breached map[[sha1.Size]byte]struct{}
+}
+
+func NewPolicy(config *config.ServiceConfig) (Policy, error) {
+	p := &policy{
+		config: config,
+	}
+
+	for _, s := range config.PasswordBannedSubstrings {
+		s = strings.ToLower(strings.TrimSpace(s))
+		if s != "" {
+			p.banned = append(p.banned, s)
+		}
+	}
+
+	if config.BreachedPasswordsFile != "" {
+		breached, err := loadBreachedList(config.BreachedPasswordsFile)
+		if err != nil {
+			return nil, err
+		}
+		p.breached = breached
+	}
+	return p, nil
+}
+
+func (p *policy) Check(password, email string) error {
+	var violations []errors.PolicyViolation
+	violate := func(rule, format string, args ...interface{}) {
+		violations = append(violations, errors.PolicyViolation{
+			Rule:        rule,
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+
+	length := utf8.RuneCountInString(password)
+	if length < p.config.PasswordMinLength {
+		violate(RuleMinLength, "must be at least %d characters long", p.config.PasswordMinLength)
+	}
+	if p.config.PasswordMaxLength > 0 && length > p.config.PasswordMaxLength {
+		violate(RuleMaxLength, "must be at most %d characters long", p.config.PasswordMaxLength)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+	if p.config.PasswordRequireUpper && !upper {
+		violate(RuleUpper, "must contain an uppercase letter")
+	}
+	if p.config.PasswordRequireLower && !lower {
+		violate(RuleLower, "must contain a lowercase letter")
+	}
+	if p.config.PasswordRequireDigit && !digit {
+		violate(RuleDigit, "must contain a digit")
+	}
+	if p.config.PasswordRequireSymbol && !symbol {
+		violate(RuleSymbol, "must contain a symbol")
+	}
+
+	lowered := strings.ToLower(password)
+	for _, s := range p.banned {
+		if strings.Contains(lowered, s) {
+			violate(RuleBannedSubstring, "must not contain %q", s)
+		}
+	}
+
+	local := strings.ToLower(email)
+	if i := strings.LastIndex(local, "@"); i >= 0 {
+		local = local[:i]
+	}
+	if utf8.RuneCountInString(local) >= minEmailPartLen && strings.Contains(lowered, local) {
+		violate(RuleEmail, "must not contain the account email")
+	}
+
+	if p.isBreached(password) {
+		violate(RuleBreached, "has appeared in a known data breach")
+	}
+
+	if len(violations) > 0 {
+		return &errors.PolicyError{
+			Violations: violations,
+		}
+	}
+	return nil
+}
+
+func (p *policy) isBreached(password string) bool {
+	if p.breached == nil {
+		return false
+	}
+	_, ok := p.breached[sha1.Sum([]byte(password))]
+	return ok
+}
+
+// loadBreachedList reads a file of hex-encoded SHA-1 password hashes, one per
+// line. The "HASH:COUNT" format of the Pwned Passwords dumps is accepted too.
+func loadBreachedList(path string) (map[[sha1.Size]byte]struct{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	breached := make(map[[sha1.Size]byte]struct{})
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if i := strings.IndexByte(text, ':'); i >= 0 {
+			text = text[:i]
+		}
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		var sum [sha1.Size]byte
+		if hex.DecodedLen(len(text)) != sha1.Size {
+			return nil, fmt.Errorf("%s:%d: malformed sha1 hash", path, line)
+		}
+		_, err := hex.Decode(sum[:], []byte(text))
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: malformed sha1 hash", path, line)
+		}
+		breached[sum] = struct{}{}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+	return breached, nil
+}
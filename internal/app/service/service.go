@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-redis/redis/v7"
 	opentracing "github.com/opentracing/opentracing-go"
@@ -12,11 +13,37 @@ import (
 type AuthService interface {
 	SetKV(ctx context.Context, key, value string) (bool, error)
 	GetKV(ctx context.Context, key string) (string, error)
+	SetKVWithTTL(ctx context.Context, key, value string, ttl time.Duration) (bool, error)
+	SetKVNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error)
+	DelKV(ctx context.Context, keys ...string) (int64, error)
+	// DelKVIfEqual deletes key only if it still holds value, e.g. to release
+	// a lock without dropping one another holder took after it expired.
+	DelKVIfEqual(ctx context.Context, key, value string) (bool, error)
+	// IncrKV adds delta to the counter at key and returns the new value. The
+	// ttl starts when the counter is created and isn't extended after.
+	IncrKV(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error)
 
 	StartSpan(ctx context.Context, name string) opentracing.Span
 	ContextWithSpan(ctx context.Context, span opentracing.Span) context.Context
 }
 
+// delIfEqualScript compares and deletes atomically.
+var delIfEqualScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// incrScript increments and sets the expiry of a new counter atomically.
+var incrScript = redis.NewScript(`
+local n = redis.call("INCRBY", KEYS[1], ARGV[1])
+if redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return n
+`)
+
 type authService struct {
 	redisClient *redis.Client
 	tracer      opentracing.Tracer
@@ -71,6 +98,102 @@ func (a *authService) getKV(key string) (string, error) {
 	return val, nil
 }
 
+func (a *authService) SetKVWithTTL(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	methodName := "SetKVWithTTL/redis"
+
+	span := a.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ok, err := a.setKVWithTTL(key, value, ttl)
+	if err != nil {
+		err = a.wrapError(err, methodName)
+	}
+	return ok, err
+}
+
+func (a *authService) setKVWithTTL(key, value string, ttl time.Duration) (bool, error) {
+	err := a.redisClient.Set(key, value, ttl).Err()
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (a *authService) SetKVNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	methodName := "SetKVNX/redis"
+
+	span := a.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ok, err := a.setKVNX(key, value, ttl)
+	if err != nil {
+		err = a.wrapError(err, methodName)
+	}
+	return ok, err
+}
+
+func (a *authService) setKVNX(key, value string, ttl time.Duration) (bool, error) {
+	return a.redisClient.SetNX(key, value, ttl).Result()
+}
+
+func (a *authService) DelKV(ctx context.Context, keys ...string) (int64, error) {
+	methodName := "DelKV/redis"
+
+	span := a.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	n, err := a.delKV(keys...)
+	if err != nil {
+		err = a.wrapError(err, methodName)
+	}
+	return n, err
+}
+
+func (a *authService) delKV(keys ...string) (int64, error) {
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	return a.redisClient.Del(keys...).Result()
+}
+
+func (a *authService) DelKVIfEqual(ctx context.Context, key, value string) (bool, error) {
+	methodName := "DelKVIfEqual/redis"
+
+	span := a.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ok, err := a.delKVIfEqual(key, value)
+	if err != nil {
+		err = a.wrapError(err, methodName)
+	}
+	return ok, err
+}
+
+func (a *authService) delKVIfEqual(key, value string) (bool, error) {
+	n, err := delIfEqualScript.Run(a.redisClient, []string{key}, value).Int64()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (a *authService) IncrKV(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	methodName := "IncrKV/redis"
+
+	span := a.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	n, err := a.incrKV(key, delta, ttl)
+	if err != nil {
+		err = a.wrapError(err, methodName)
+	}
+	return n, err
+}
+
+func (a *authService) incrKV(key string, delta int64, ttl time.Duration) (int64, error) {
+	return incrScript.Run(a.redisClient, []string{key}, delta, ttl.Milliseconds()).Int64()
+}
+
 func (a *authService) StartSpan(ctx context.Context, name string) opentracing.Span {
 	span := opentracing.SpanFromContext(ctx)
 	if span != nil {
@@ -87,7 +210,10 @@ func (a *authService) ContextWithSpan(ctx context.Context, span opentracing.Span
 }
 
 func (a *authService) wrapError(err error, method string) error {
-	return errors.ServiceError{
+	if err == redis.Nil {
+		err = errors.ErrNotFound
+	}
+	return &errors.ServiceError{
 		Method: method,
 		Err:    err,
 	}
@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+
+	"github.com/barugoo/oscillo-auth/internal/app/errors"
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/session"
+)
+
+const (
+	redisDB = "redis"
+
+	sessionsKeyTemplate = "sessions:%s"
+)
+
+// touchScript writes a session only if it's still there, so a session
+// revoked while in use doesn't come back.
+var touchScript = redis.NewScript(`
+if redis.call("HEXISTS", KEYS[1], ARGV[1]) == 0 then
+	return 0
+end
+redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+return 1
+`)
+
+type sessionRepository struct {
+	service     service.AuthService
+	redisClient *redis.Client
+	ttl         time.Duration
+}
+
+func NewSessionRepository(service service.AuthService, redisClient *redis.Client, ttl time.Duration) SessionRepository {
+	return &sessionRepository{
+		service:     service,
+		redisClient: redisClient,
+		ttl:         ttl,
+	}
+}
+
+func (h *sessionRepository) GetSession(ctx context.Context, accountID, sessionID string) (*models.Session, error) {
+	span := h.service.StartSpan(ctx, "GetSession")
+	defer span.Finish()
+
+	session, err := h.getSession(accountID, sessionID)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return session, err
+}
+
+func (h *sessionRepository) getSession(accountID, sessionID string) (*models.Session, error) {
+	data, err := h.redisClient.HGet(h.key(accountID), sessionID).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var session *models.Session
+	err = json.Unmarshal([]byte(data), &session)
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (h *sessionRepository) ListSessions(ctx context.Context, accountID string) ([]*models.Session, error) {
+	span := h.service.StartSpan(ctx, "ListSessions")
+	defer span.Finish()
+
+	sessions, err := h.listSessions(accountID)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return sessions, err
+}
+
+func (h *sessionRepository) listSessions(accountID string) ([]*models.Session, error) {
+	values, err := h.redisClient.HGetAll(h.key(accountID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*models.Session, 0, len(values))
+	for _, data := range values {
+		var session *models.Session
+		err = json.Unmarshal([]byte(data), &session)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+func (h *sessionRepository) CreateSession(ctx context.Context, session *models.Session) (*models.Session, error) {
+	span := h.service.StartSpan(ctx, "CreateSession")
+	defer span.Finish()
+
+	session, err := h.saveSession(session)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return session, err
+}
+
+func (h *sessionRepository) UpdateSession(ctx context.Context, session *models.Session) (*models.Session, error) {
+	span := h.service.StartSpan(ctx, "UpdateSession")
+	defer span.Finish()
+
+	session, err := h.saveSession(session)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return session, err
+}
+
+func (h *sessionRepository) saveSession(session *models.Session) (*models.Session, error) {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return nil, err
+	}
+
+	key := h.key(session.AccountID)
+
+	_, err = h.redisClient.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.HSet(key, session.ID, data)
+		pipe.Expire(key, h.ttl)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (h *sessionRepository) TouchSession(ctx context.Context, session *models.Session, at time.Time) (bool, error) {
+	span := h.service.StartSpan(ctx, "TouchSession")
+	defer span.Finish()
+
+	ok, err := h.touchSession(session, at)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return ok, err
+}
+
+func (h *sessionRepository) touchSession(session *models.Session, at time.Time) (bool, error) {
+	touched := *session
+	touched.LastSeenAt = at
+
+	data, err := json.Marshal(&touched)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := touchScript.Run(h.redisClient, []string{h.key(session.AccountID)}, session.ID, data, h.ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, nil
+	}
+	session.LastSeenAt = at
+	return true, nil
+}
+
+func (h *sessionRepository) DeleteSessions(ctx context.Context, accountID string, sessionIDs ...string) (int64, error) {
+	span := h.service.StartSpan(ctx, "DeleteSessions")
+	defer span.Finish()
+
+	n, err := h.deleteSessions(accountID, sessionIDs...)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return n, err
+}
+
+func (h *sessionRepository) deleteSessions(accountID string, sessionIDs ...string) (int64, error) {
+	if len(sessionIDs) == 0 {
+		return 0, nil
+	}
+	return h.redisClient.HDel(h.key(accountID), sessionIDs...).Result()
+}
+
+func (h *sessionRepository) DeleteAllSessions(ctx context.Context, accountID string) (int64, error) {
+	span := h.service.StartSpan(ctx, "DeleteAllSessions")
+	defer span.Finish()
+
+	n, err := h.deleteAllSessions(accountID)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return n, err
+}
+
+func (h *sessionRepository) deleteAllSessions(accountID string) (int64, error) {
+	return h.redisClient.Del(h.key(accountID)).Result()
+}
+
+func (h *sessionRepository) key(accountID string) string {
+	return fmt.Sprintf(sessionsKeyTemplate, accountID)
+}
+
+func (h *sessionRepository) wrapError(err error) error {
+
+	switch err {
+	case redis.Nil:
+		err = errors.ErrNotFound
+	default:
+		err = fmt.Errorf("%v", err)
+	}
+	return &errors.RepositoryError{
+		Impl: redisDB,
+		Err:  err,
+	}
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/session"
+)
+
+type SessionRepository interface {
+	GetSession(ctx context.Context, accountID, sessionID string) (*models.Session, error)
+	ListSessions(ctx context.Context, accountID string) ([]*models.Session, error)
+	CreateSession(ctx context.Context, session *models.Session) (*models.Session, error)
+	UpdateSession(ctx context.Context, session *models.Session) (*models.Session, error)
+	// TouchSession sets LastSeenAt, unless the session was deleted meanwhile.
+	TouchSession(ctx context.Context, session *models.Session, at time.Time) (bool, error)
+	DeleteSessions(ctx context.Context, accountID string, sessionIDs ...string) (int64, error)
+	DeleteAllSessions(ctx context.Context, accountID string) (int64, error)
+}
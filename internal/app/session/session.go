@@ -0,0 +1,26 @@
+package session
+
+import (
+	"time"
+)
+
+type Session struct {
+	ID         string    `json:"id"`
+	AccountID  string    `json:"account_id"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"user_agent"`
+	Current    bool      `json:"-"`
+}
+
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+// IsIdle reports whether the session went unused for longer than timeout.
+// A zero timeout never idles a session out.
+func (s *Session) IsIdle(now time.Time, timeout time.Duration) bool {
+	return timeout > 0 && now.Sub(s.LastSeenAt) > timeout
+}
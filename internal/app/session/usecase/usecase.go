@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/barugoo/oscillo-auth/config"
+
+	"github.com/barugoo/oscillo-auth/internal/app/errors"
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/session"
+	"github.com/barugoo/oscillo-auth/internal/app/session/repository"
+
+	tokenUsecase "github.com/barugoo/oscillo-auth/internal/app/token/usecase"
+)
+
+type SessionUsecase interface {
+	StartSession(ctx context.Context, accountID string, client *models.ClientInfo) (*models.Session, error)
+	ListSessions(ctx context.Context, accessToken string) ([]*models.Session, error)
+	RevokeSession(ctx context.Context, accessToken, sessionID string) (bool, error)
+	RevokeOtherSessions(ctx context.Context, accessToken string) (int64, error)
+	RevokeAccountSessions(ctx context.Context, accountID string) (int64, error)
+}
+
+const (
+	usecaseMethodTemplate = "%s/usecase"
+
+	sessionIDBytes = 16
+)
+
+type sessionUsecase struct {
+	service    service.AuthService
+	config     *config.ServiceConfig
+	repository repository.SessionRepository
+	tokenCase  tokenUsecase.TokenUsecase
+}
+
+func NewSessionUsecase(config *config.ServiceConfig, service service.AuthService, repository repository.SessionRepository, tokenUsecase tokenUsecase.TokenUsecase) SessionUsecase {
+	return &sessionUsecase{
+		config:     config,
+		service:    service,
+		repository: repository,
+		tokenCase:  tokenUsecase,
+	}
+}
+
+func (uc *sessionUsecase) StartSession(ctx context.Context, accountID string, client *models.ClientInfo) (*models.Session, error) {
+	methodName := uc.getMethodFromContext(ctx, "StartSession")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	session, err := uc.startSession(ctx, accountID, client)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return session, err
+}
+
+func (uc *sessionUsecase) startSession(ctx context.Context, accountID string, client *models.ClientInfo) (*models.Session, error) {
+	id, err := uc.generateSessionID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	session := &models.Session{
+		ID:         id,
+		AccountID:  accountID,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+	if client != nil {
+		session.IP = client.IP
+		session.UserAgent = client.UserAgent
+	}
+
+	return uc.repository.CreateSession(ctx, session)
+}
+
+func (uc *sessionUsecase) ListSessions(ctx context.Context, accessToken string) ([]*models.Session, error) {
+	methodName := uc.getMethodFromContext(ctx, "ListSessions")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	sessions, err := uc.listSessions(ctx, accessToken)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return sessions, err
+}
+
+func (uc *sessionUsecase) listSessions(ctx context.Context, accessToken string) ([]*models.Session, error) {
+	claims, err := uc.tokenCase.ParseAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := uc.repository.ListSessions(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	active := make([]*models.Session, 0, len(sessions))
+	var expired []string
+
+	for _, session := range sessions {
+		if session.IsIdle(now, uc.config.SessionIdleTimeout) || now.Sub(session.LastSeenAt) > uc.config.RefreshTokenTTL {
+			expired = append(expired, session.ID)
+			continue
+		}
+		session.Current = session.ID == claims.SessionID
+		active = append(active, session)
+	}
+
+	_, err = uc.repository.DeleteSessions(ctx, claims.Subject, expired...)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].LastSeenAt.After(active[j].LastSeenAt)
+	})
+	return active, nil
+}
+
+func (uc *sessionUsecase) RevokeSession(ctx context.Context, accessToken, sessionID string) (bool, error) {
+	methodName := uc.getMethodFromContext(ctx, "RevokeSession")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	ok, err := uc.revokeSession(ctx, accessToken, sessionID)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return ok, err
+}
+
+func (uc *sessionUsecase) revokeSession(ctx context.Context, accessToken, sessionID string) (bool, error) {
+	claims, err := uc.tokenCase.ParseAccessToken(ctx, accessToken)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := uc.repository.DeleteSessions(ctx, claims.Subject, sessionID)
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, errors.ErrSessionNotFound
+	}
+	return true, nil
+}
+
+func (uc *sessionUsecase) RevokeOtherSessions(ctx context.Context, accessToken string) (int64, error) {
+	methodName := uc.getMethodFromContext(ctx, "RevokeOtherSessions")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	n, err := uc.revokeOtherSessions(ctx, accessToken)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return n, err
+}
+
+func (uc *sessionUsecase) revokeOtherSessions(ctx context.Context, accessToken string) (int64, error) {
+	claims, err := uc.tokenCase.ParseAccessToken(ctx, accessToken)
+	if err != nil {
+		return 0, err
+	}
+
+	sessions, err := uc.repository.ListSessions(ctx, claims.Subject)
+	if err != nil {
+		return 0, err
+	}
+
+	others := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		if session.ID != claims.SessionID {
+			others = append(others, session.ID)
+		}
+	}
+
+	return uc.repository.DeleteSessions(ctx, claims.Subject, others...)
+}
+
+func (uc *sessionUsecase) RevokeAccountSessions(ctx context.Context, accountID string) (int64, error) {
+	methodName := uc.getMethodFromContext(ctx, "RevokeAccountSessions")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	n, err := uc.repository.DeleteAllSessions(ctx, accountID)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return n, err
+}
+
+func (uc *sessionUsecase) generateSessionID() (string, error) {
+	b := make([]byte, sessionIDBytes)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (uc *sessionUsecase) wrapError(err error, method string) error {
+	return &errors.UsecaseError{
+		Method: method,
+		Err:    err,
+	}
+}
+
+func (uc *sessionUsecase) getMethodFromContext(ctx context.Context, name string) string {
+	if method, ok := ctx.Value("method").(string); ok {
+		name = method
+	}
+	return fmt.Sprintf(usecaseMethodTemplate, name)
+}
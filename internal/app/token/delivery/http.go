@@ -0,0 +1,61 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/barugoo/oscillo-auth/config"
+
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+
+	"github.com/barugoo/oscillo-auth/internal/app/token/usecase"
+)
+
+const (
+	JWKSPath = "/.well-known/jwks.json"
+
+	deliveryMethodTemplate = "%s/delivery"
+)
+
+type jwksHandler struct {
+	config    *config.ServiceConfig
+	service   service.AuthService
+	tokenCase usecase.TokenUsecase
+}
+
+func NewJWKSHandler(config *config.ServiceConfig, service service.AuthService, tokenUsecase usecase.TokenUsecase) http.Handler {
+	return &jwksHandler{
+		config:    config,
+		service:   service,
+		tokenCase: tokenUsecase,
+	}
+}
+
+func (h *jwksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	methodName := fmt.Sprintf(deliveryMethodTemplate, r.URL.Path)
+
+	span := h.service.StartSpan(r.Context(), methodName)
+	defer span.Finish()
+
+	spanCtx := h.service.ContextWithSpan(context.Background(), span)
+	methodCtx := context.WithValue(spanCtx, "method", methodName)
+
+	jwks, err := h.tokenCase.GetJWKS(methodCtx)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", h.config.JWKSMaxAge/time.Second))
+	json.NewEncoder(w).Encode(jwks)
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/barugoo/oscillo-auth/internal/app/errors"
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+const (
+	mongoDB = "mongoDB"
+)
+
+type keyRepository struct {
+	service    service.AuthService
+	collection *mongo.Collection
+}
+
+func NewKeyRepository(service service.AuthService, collection *mongo.Collection) KeyRepository {
+	return &keyRepository{
+		service:    service,
+		collection: collection,
+	}
+}
+
+func (h *keyRepository) GetKeys(ctx context.Context) ([]*models.Key, error) {
+	span := h.service.StartSpan(ctx, "GetKeys")
+	defer span.Finish()
+
+	keys, err := h.getKeys()
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return keys, err
+}
+
+func (h *keyRepository) getKeys() ([]*models.Key, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdat", Value: -1}})
+
+	cursor, err := h.collection.Find(context.TODO(), bson.D{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.TODO())
+
+	var keys []*models.Key
+	err = cursor.All(context.TODO(), &keys)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (h *keyRepository) CreateKey(ctx context.Context, key *models.Key) (*models.Key, error) {
+	span := h.service.StartSpan(ctx, "CreateKey")
+	defer span.Finish()
+
+	key, err := h.createKey(key)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return key, err
+}
+
+func (h *keyRepository) createKey(key *models.Key) (*models.Key, error) {
+	_, err := h.collection.InsertOne(context.TODO(), key)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (h *keyRepository) DeleteExpiredKeys(ctx context.Context, now time.Time) (int64, error) {
+	span := h.service.StartSpan(ctx, "DeleteExpiredKeys")
+	defer span.Finish()
+
+	n, err := h.deleteExpiredKeys(now)
+	if err != nil {
+		err = h.wrapError(err)
+	}
+	return n, err
+}
+
+func (h *keyRepository) deleteExpiredKeys(now time.Time) (int64, error) {
+	filter := bson.D{{Key: "expiresat", Value: bson.D{{Key: "$lte", Value: now}}}}
+
+	result, err := h.collection.DeleteMany(context.TODO(), filter)
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
+func (h *keyRepository) wrapError(err error) error {
+
+	switch err {
+	case mongo.ErrNoDocuments:
+		err = errors.ErrNotFound
+	default:
+		err = fmt.Errorf("%v", err)
+	}
+	return &errors.RepositoryError{
+		Impl: mongoDB,
+		Err:  err,
+	}
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+type KeyRepository interface {
+	GetKeys(ctx context.Context) ([]*models.Key, error)
+	CreateKey(ctx context.Context, key *models.Key) (*models.Key, error)
+	DeleteExpiredKeys(ctx context.Context, now time.Time) (int64, error)
+}
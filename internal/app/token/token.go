@@ -0,0 +1,108 @@
+package token
+
+import (
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	UseAccess     = "access"
+	UseMFAPending = "mfa_pending"
+)
+
+type Claims struct {
+	jwt.StandardClaims
+	Use       string `json:"token_use"`
+	SessionID string `json:"sid,omitempty"`
+	Email     string `json:"email"`
+	Has2FA    bool   `json:"has_2fa"`
+	// IssuedAtMs is iat in milliseconds, so revocation can tell tokens
+	// issued within the same second apart.
+	IssuedAtMs int64 `json:"iat_ms,omitempty"`
+}
+
+// IssuedAtMillis falls back to iat for tokens issued without iat_ms.
+func (c *Claims) IssuedAtMillis() int64 {
+	if c.IssuedAtMs != 0 {
+		return c.IssuedAtMs
+	}
+	return c.IssuedAt * 1000
+}
+
+type Validation struct {
+	Claims *Claims
+	Err    error
+}
+
+type Token struct {
+	Value     string
+	ExpiresAt time.Time
+}
+
+type TokenPair struct {
+	Access  *Token
+	Refresh *Token
+}
+
+type AuthResult struct {
+	Tokens    *TokenPair
+	Challenge *Token
+}
+
+type RefreshToken struct {
+	FamilyID  string    `json:"family_id"`
+	AccountID string    `json:"account_id"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Key is published from its creation until ExpiresAt and signs from
+// ActivatesAt until RetiresAt, so verifiers know it before the first token
+// signed with it arrives and after the last one expires.
+type Key struct {
+	ID         string `json:"id"`
+	Algorithm  string `json:"algorithm"`
+	PrivateKey []byte `json:"private_key"`
+	// EncryptionKeyVersion names the configured key PrivateKey is encrypted
+	// with. Keys stored before encryption have none.
+	EncryptionKeyVersion string    `json:"encryption_key_version,omitempty"`
+	CreatedAt            time.Time `json:"created_at"`
+	ActivatesAt          time.Time `json:"activates_at"`
+	RetiresAt            time.Time `json:"retires_at"`
+	ExpiresAt            time.Time `json:"expires_at"`
+}
+
+func (k *Key) IsEncrypted() bool {
+	return k.EncryptionKeyVersion != ""
+}
+
+// IsSigning treats keys stored before ActivatesAt existed as active from
+// their creation.
+func (k *Key) IsSigning(now time.Time) bool {
+	activatesAt := k.ActivatesAt
+	if activatesAt.IsZero() {
+		activatesAt = k.CreatedAt
+	}
+	return !now.Before(activatesAt) && now.Before(k.RetiresAt)
+}
+
+func (k *Key) IsPublished(now time.Time) bool {
+	return now.Before(k.ExpiresAt)
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
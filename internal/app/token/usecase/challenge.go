@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	accountModels "github.com/barugoo/oscillo-auth/internal/app/account"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+const (
+	usedChallengeKeyTemplate = "used_challenge:%s"
+)
+
+func (uc *tokenUsecase) IssueChallengeToken(ctx context.Context, account *accountModels.Account) (*models.Token, error) {
+	methodName := uc.getMethodFromContext(ctx, "IssueChallengeToken")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	token, err := uc.issueChallengeToken(account)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return token, err
+}
+
+func (uc *tokenUsecase) issueChallengeToken(account *accountModels.Account) (*models.Token, error) {
+	claims, err := uc.newClaims(account, "", models.UseMFAPending, uc.config.ChallengeTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := uc.signToken(claims)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Token{
+		Value:     value,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+	}, nil
+}
+
+func (uc *tokenUsecase) ConsumeChallengeToken(ctx context.Context, challengeToken string) (*models.Claims, error) {
+	methodName := uc.getMethodFromContext(ctx, "ConsumeChallengeToken")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	claims, err := uc.consumeChallengeToken(ctx, challengeToken)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return claims, err
+}
+
+// a challenge is spent by the first attempt to complete it, whatever the
+// outcome, so its TOTP code can't be brute-forced within the challenge TTL.
+func (uc *tokenUsecase) consumeChallengeToken(ctx context.Context, challengeToken string) (*models.Claims, error) {
+	claims, err := uc.parseClaims(challengeToken, models.UseMFAPending)
+	if err != nil {
+		return nil, errs.ErrInvalidChallenge
+	}
+
+	ok, err := uc.service.SetKVNX(ctx, fmt.Sprintf(usedChallengeKeyTemplate, claims.Id), claims.Subject, uc.config.ChallengeTTL)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errs.ErrInvalidChallenge
+	}
+	return claims, nil
+}
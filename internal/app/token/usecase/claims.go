@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	accountModels "github.com/barugoo/oscillo-auth/internal/app/account"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+func (uc *tokenUsecase) newClaims(account *accountModels.Account, sessionID, use string, ttl time.Duration) (*models.Claims, error) {
+	id, err := generateRandomID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &models.Claims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        id,
+			Issuer:    uc.config.TokenIssuer,
+			Audience:  uc.config.TokenAudience,
+			Subject:   account.ID,
+			IssuedAt:  now.Unix(),
+			NotBefore: now.Unix(),
+			ExpiresAt: now.Add(ttl).Unix(),
+		},
+		Use:        use,
+		SessionID:  sessionID,
+		Email:      account.Email,
+		Has2FA:     account.Has2FA(),
+		IssuedAtMs: unixMillis(now),
+	}, nil
+}
+
+func unixMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func (uc *tokenUsecase) parseClaims(value, use string) (*models.Claims, error) {
+	claims := &models.Claims{}
+
+	_, err := jwt.ParseWithClaims(value, claims, uc.publicKey)
+	if err != nil {
+		verr, ok := err.(*jwt.ValidationError)
+		if ok && verr.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, errs.ErrTokenExpired
+		}
+		return nil, errs.ErrInvalidToken
+	}
+
+	if !claims.VerifyIssuer(uc.config.TokenIssuer, uc.config.TokenIssuer != "") {
+		return nil, errs.ErrInvalidToken
+	}
+	if !claims.VerifyAudience(uc.config.TokenAudience, uc.config.TokenAudience != "") {
+		return nil, errs.ErrInvalidToken
+	}
+	if claims.Use != use || claims.Id == "" || claims.Subject == "" {
+		return nil, errs.ErrInvalidToken
+	}
+	return claims, nil
+}
+
+func (uc *tokenUsecase) publicKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	uc.mu.RLock()
+	published := uc.published
+	uc.mu.RUnlock()
+
+	for _, key := range published {
+		if key.id == kid && key.method.Alg() == token.Method.Alg() {
+			return key.public, nil
+		}
+	}
+	return nil, errs.ErrKeyNotFound
+}
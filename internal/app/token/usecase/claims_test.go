@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+// sign signs claims with private under kid, whatever key the usecase uses.
+func sign(t *testing.T, method jwt.SigningMethod, private interface{}, kid string, claims jwt.Claims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	value, err := token.SignedString(private)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+	return value
+}
+
+// newPublishedKey publishes another key of alg next to the signing one.
+func (tu *testUsecase) newPublishedKey(t *testing.T, alg string) *signingKey {
+	t.Helper()
+
+	private, err := generatePrivateKey(alg)
+	if err != nil {
+		t.Fatalf("generatePrivateKey: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey: %v", err)
+	}
+	key, err := parseSigningKey(&models.Key{ID: alg + "-key", Algorithm: alg}, der)
+	if err != nil {
+		t.Fatalf("parseSigningKey: %v", err)
+	}
+
+	tu.published = append(tu.published, key)
+	return key
+}
+
+func TestParseClaims(t *testing.T) {
+	tu := newTestUsecase(t, testConfig())
+	rsaKey := tu.newPublishedKey(t, "RS256")
+
+	claims := func(change func(*models.Claims)) *models.Claims {
+		c, err := tu.newClaims(tu.account, "session", models.UseAccess, time.Minute)
+		if err != nil {
+			t.Fatalf("newClaims: %v", err)
+		}
+		if change != nil {
+			change(c)
+		}
+		return c
+	}
+	signed := func(change func(*models.Claims)) string {
+		return sign(t, tu.signing.method, tu.signing.private, tu.signing.id, claims(change))
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		use   string
+		err   error
+	}{
+		{
+			name:  "valid",
+			token: signed(nil),
+			use:   models.UseAccess,
+		},
+		{
+			name:  "signed by a published key",
+			token: sign(t, rsaKey.method, rsaKey.private, rsaKey.id, claims(nil)),
+			use:   models.UseAccess,
+		},
+		{
+			name:  "unknown kid",
+			token: sign(t, tu.signing.method, tu.signing.private, "unknown", claims(nil)),
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "no kid",
+			token: sign(t, tu.signing.method, tu.signing.private, "", claims(nil)),
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "alg of another key",
+			token: sign(t, tu.signing.method, tu.signing.private, rsaKey.id, claims(nil)),
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "alg none",
+			token: sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, tu.signing.id, claims(nil)),
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "HMAC with the public key",
+			token: sign(t, jwt.SigningMethodHS256, []byte(rsaKey.id), rsaKey.id, claims(nil)),
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "wrong use",
+			token: signed(func(c *models.Claims) { c.Use = models.UseMFAPending }),
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "challenge as access token",
+			token: signed(nil),
+			use:   models.UseMFAPending,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "wrong aud",
+			token: signed(func(c *models.Claims) { c.Audience = "other" }),
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "no aud",
+			token: signed(func(c *models.Claims) { c.Audience = "" }),
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "wrong iss",
+			token: signed(func(c *models.Claims) { c.Issuer = "other" }),
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "expired",
+			token: signed(func(c *models.Claims) { c.ExpiresAt = time.Now().Add(-time.Minute).Unix() }),
+			use:   models.UseAccess,
+			err:   errs.ErrTokenExpired,
+		},
+		{
+			name:  "not valid yet",
+			token: signed(func(c *models.Claims) { c.NotBefore = time.Now().Add(time.Hour).Unix() }),
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "no sub",
+			token: signed(func(c *models.Claims) { c.Subject = "" }),
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "no jti",
+			token: signed(func(c *models.Claims) { c.Id = "" }),
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+		{
+			name:  "tampered",
+			token: signed(nil) + "A",
+			use:   models.UseAccess,
+			err:   errs.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tu.parseClaims(tt.token, tt.use)
+			if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+				t.Fatalf("parseClaims() error = %v, want %v", err, tt.err)
+			}
+			if tt.err == nil && got.Subject != tu.account.ID {
+				t.Errorf("parseClaims() subject = %q, want %q", got.Subject, tu.account.ID)
+			}
+		})
+	}
+}
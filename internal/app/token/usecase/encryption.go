@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+const encryptionKeyBytes = 32
+
+// keyEncryption seals private signing keys with AES-GCM before they're
+// stored, so a dump of the key collection isn't enough to sign tokens. The
+// encryption keys come from the config and are versioned like password
+// peppers: older versions stay configured to open the keys sealed with them.
+type keyEncryption struct {
+	keys    map[string]cipher.AEAD
+	current string
+}
+
+func newKeyEncryption(keys map[string]string, current string) (*keyEncryption, error) {
+	e := &keyEncryption{
+		keys:    make(map[string]cipher.AEAD, len(keys)),
+		current: current,
+	}
+
+	for version, encoded := range keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || version == "" || len(key) != encryptionKeyBytes {
+			return nil, fmt.Errorf("%w: version %q", errors.ErrInvalidEncryptionKey, version)
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		e.keys[version], err = cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := e.keys[current]; !ok {
+		return nil, fmt.Errorf("%w: version %q", errors.ErrUnknownEncryptionKey, current)
+	}
+	return e, nil
+}
+
+// seal stores der encrypted in key. The key ID is authenticated along, so a
+// sealed private key can't be moved to another key record.
+func (e *keyEncryption) seal(key *models.Key, der []byte) error {
+	aead := e.keys[e.current]
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return err
+	}
+
+	key.PrivateKey = aead.Seal(nonce, nonce, der, []byte(key.ID))
+	key.EncryptionKeyVersion = e.current
+	return nil
+}
+
+func (e *keyEncryption) open(key *models.Key) ([]byte, error) {
+	if !key.IsEncrypted() {
+		return key.PrivateKey, nil
+	}
+
+	aead, ok := e.keys[key.EncryptionKeyVersion]
+	if !ok {
+		return nil, fmt.Errorf("%w: version %q", errors.ErrUnknownEncryptionKey, key.EncryptionKeyVersion)
+	}
+	if len(key.PrivateKey) < aead.NonceSize() {
+		return nil, errors.ErrInvalidEncryptionKey
+	}
+
+	nonce, sealed := key.PrivateKey[:aead.NonceSize()], key.PrivateKey[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, []byte(key.ID))
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/barugoo/oscillo-auth/pkg/eddsa"
+
+	"github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+const (
+	rsaKeyBits    = 2048
+	randomIDBytes = 16
+)
+
+type signingKey struct {
+	id      string
+	method  jwt.SigningMethod
+	private crypto.PrivateKey
+	public  crypto.PublicKey
+}
+
+func generatePrivateKey(alg string) (crypto.PrivateKey, error) {
+	switch alg {
+	case jwt.SigningMethodRS256.Alg():
+		return rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case jwt.SigningMethodES256.Alg():
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case eddsa.SigningMethodEdDSA.Alg():
+		_, private, err := ed25519.GenerateKey(rand.Reader)
+		return private, err
+	default:
+		return nil, errors.ErrUnsupportedAlgorithm
+	}
+}
+
+func generateRandomID() (string, error) {
+	b := make([]byte, randomIDBytes)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// parseSigningKey takes the key's decrypted PKCS8 private key.
+func parseSigningKey(key *models.Key, der []byte) (*signingKey, error) {
+	method := jwt.GetSigningMethod(key.Algorithm)
+	if method == nil {
+		return nil, errors.ErrUnsupportedAlgorithm
+	}
+
+	private, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+
+	var public crypto.PublicKey
+	switch k := private.(type) {
+	case *rsa.PrivateKey:
+		public = &k.PublicKey
+	case *ecdsa.PrivateKey:
+		public = &k.PublicKey
+	case ed25519.PrivateKey:
+		public = k.Public()
+	default:
+		return nil, errors.ErrUnsupportedAlgorithm
+	}
+
+	return &signingKey{
+		id:      key.ID,
+		method:  method,
+		private: private,
+		public:  public,
+	}, nil
+}
+
+func (k *signingKey) jwk() (models.JWK, error) {
+	jwk := models.JWK{
+		Kid: k.id,
+		Use: "sig",
+		Alg: k.method.Alg(),
+	}
+
+	switch public := k.public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = encodeBase64(public.N.Bytes())
+		jwk.E = encodeBase64(big.NewInt(int64(public.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (public.Curve.Params().BitSize + 7) / 8
+		jwk.Kty = "EC"
+		jwk.Crv = public.Curve.Params().Name
+		jwk.X = encodeBase64(padBytes(public.X.Bytes(), size))
+		jwk.Y = encodeBase64(padBytes(public.Y.Bytes(), size))
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = encodeBase64(public)
+	default:
+		return jwk, fmt.Errorf("%w: %T", errors.ErrUnsupportedAlgorithm, public)
+	}
+	return jwk, nil
+}
+
+func encodeBase64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func padBytes(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b
+	}
+	padded := make([]byte, size)
+	copy(padded[size-len(b):], b)
+	return padded
+}
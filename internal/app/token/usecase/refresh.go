@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	accountModels "github.com/barugoo/oscillo-auth/internal/app/account"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+const (
+	refreshTokenBytes = 32
+
+	refreshTokenKeyTemplate  = "refresh:%s"
+	refreshUsedKeyTemplate   = "refresh_used:%s"
+	refreshFamilyKeyTemplate = "refresh_family:%s"
+)
+
+func (uc *tokenUsecase) IssueRefreshToken(ctx context.Context, account *accountModels.Account, sessionID string) (*models.Token, error) {
+	methodName := uc.getMethodFromContext(ctx, "IssueRefreshToken")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	token, err := uc.issueRefreshTokenInFamily(ctx, account, sessionID)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return token, err
+}
+
+func (uc *tokenUsecase) issueRefreshTokenInFamily(ctx context.Context, account *accountModels.Account, familyID string) (*models.Token, error) {
+	value, err := generateOpaqueToken(refreshTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	record := &models.RefreshToken{
+		FamilyID:  familyID,
+		AccountID: account.ID,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(uc.config.RefreshTokenTTL),
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uc.service.SetKVWithTTL(ctx, fmt.Sprintf(refreshFamilyKeyTemplate, familyID), account.ID, uc.config.RefreshTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uc.service.SetKVWithTTL(ctx, fmt.Sprintf(refreshTokenKeyTemplate, hashToken(value)), string(data), uc.config.RefreshTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Token{
+		Value:     value,
+		ExpiresAt: record.ExpiresAt,
+	}, nil
+}
+
+func (uc *tokenUsecase) RefreshTokens(ctx context.Context, refreshToken string) (*models.TokenPair, error) {
+	methodName := uc.getMethodFromContext(ctx, "RefreshTokens")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	pair, err := uc.refreshTokens(ctx, refreshToken)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return pair, err
+}
+
+func (uc *tokenUsecase) refreshTokens(ctx context.Context, refreshToken string) (*models.TokenPair, error) {
+	hash := hashToken(refreshToken)
+
+	record, err := uc.getRefreshToken(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uc.service.GetKV(ctx, fmt.Sprintf(refreshFamilyKeyTemplate, record.FamilyID))
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errs.ErrRefreshTokenRevoked
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	validAfter, err := uc.tokensValidAfter(ctx, record.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if !record.IssuedAt.After(validAfter) {
+		return nil, errs.ErrRefreshTokenRevoked
+	}
+
+	ok, err := uc.service.SetKVNX(ctx, fmt.Sprintf(refreshUsedKeyTemplate, hash), record.FamilyID, uc.config.RefreshTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// a used token coming back means it leaked, so whoever holds the
+		// family or an access token of its session is signed out
+		_, err = uc.revokeRefreshFamily(ctx, record.FamilyID)
+		if err != nil {
+			return nil, err
+		}
+		err = uc.revokeSession(ctx, record.AccountID, record.FamilyID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errs.ErrRefreshTokenReused
+	}
+
+	session, err := uc.sessionRep.GetSession(ctx, record.AccountID, record.FamilyID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errs.ErrRefreshTokenRevoked
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := uc.accountRep.GetAccountByID(ctx, record.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = account.CheckStatus(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.touchSession(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := uc.issueAccessToken(account, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh, err := uc.issueRefreshTokenInFamily(ctx, account, record.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TokenPair{
+		Access:  access,
+		Refresh: refresh,
+	}, nil
+}
+
+func (uc *tokenUsecase) getRefreshToken(ctx context.Context, hash string) (*models.RefreshToken, error) {
+	data, err := uc.service.GetKV(ctx, fmt.Sprintf(refreshTokenKeyTemplate, hash))
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errs.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var record *models.RefreshToken
+	err = json.Unmarshal([]byte(data), &record)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (uc *tokenUsecase) revokeRefreshFamily(ctx context.Context, familyID string) (bool, error) {
+	n, err := uc.service.DelKV(ctx, fmt.Sprintf(refreshFamilyKeyTemplate, familyID))
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func generateOpaqueToken(size int) (string, error) {
+	b := make([]byte, size)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return encodeBase64(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
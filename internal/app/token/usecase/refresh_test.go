@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	accountModels "github.com/barugoo/oscillo-auth/internal/app/account"
+)
+
+func TestRefreshTokens(t *testing.T) {
+	ctx := context.Background()
+	tu := newTestUsecase(t, testConfig())
+	session := tu.newSession("session")
+
+	first, err := tu.issueRefreshTokenInFamily(ctx, tu.account, session.ID)
+	if err != nil {
+		t.Fatalf("issueRefreshTokenInFamily: %v", err)
+	}
+
+	pair, err := tu.refreshTokens(ctx, first.Value)
+	if err != nil {
+		t.Fatalf("refreshTokens: %v", err)
+	}
+	_, err = tu.parseAccessToken(ctx, pair.Access.Value)
+	if err != nil {
+		t.Fatalf("parseAccessToken of the refreshed token: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{"unknown", "unknown", errs.ErrInvalidRefreshToken},
+		// reusing the first token revokes the family, even the newer token
+		{"reused", first.Value, errs.ErrRefreshTokenReused},
+		{"rotated after reuse", pair.Refresh.Value, errs.ErrRefreshTokenRevoked},
+		{"reused again", first.Value, errs.ErrRefreshTokenRevoked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tu.refreshTokens(ctx, tt.token)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("refreshTokens() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	if tu.service.has(fmt.Sprintf(refreshFamilyKeyTemplate, session.ID)) {
+		t.Errorf("refresh family wasn't revoked")
+	}
+	if _, ok := tu.sessions.sessions[session.ID]; ok {
+		t.Errorf("session wasn't deleted")
+	}
+	if !tu.service.has(fmt.Sprintf(revokedSessionKeyTemplate, session.ID)) {
+		t.Errorf("session wasn't marked revoked")
+	}
+
+	_, err = tu.parseAccessToken(ctx, pair.Access.Value)
+	if !errors.Is(err, errs.ErrTokenRevoked) {
+		t.Errorf("parseAccessToken() of the session's access token error = %v, want %v", err, errs.ErrTokenRevoked)
+	}
+}
+
+func TestRefreshTokensRevoked(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		revoke func(tu *testUsecase)
+		err    error
+	}{
+		{
+			name: "session deleted",
+			revoke: func(tu *testUsecase) {
+				delete(tu.sessions.sessions, "session")
+			},
+			err: errs.ErrRefreshTokenRevoked,
+		},
+		{
+			name: "family revoked",
+			revoke: func(tu *testUsecase) {
+				delete(tu.service.kv, fmt.Sprintf(refreshFamilyKeyTemplate, "session"))
+			},
+			err: errs.ErrRefreshTokenRevoked,
+		},
+		{
+			name: "tokens invalidated",
+			revoke: func(tu *testUsecase) {
+				tu.service.kv[fmt.Sprintf(tokensValidAfterKeyTemplate, tu.account.ID)] = time.Now().UTC().Format(time.RFC3339Nano)
+			},
+			err: errs.ErrRefreshTokenRevoked,
+		},
+		{
+			name: "account suspended",
+			revoke: func(tu *testUsecase) {
+				tu.account.Status = accountModels.StatusSuspended
+			},
+			err: errs.ErrAccountSuspended,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tu := newTestUsecase(t, testConfig())
+			tu.newSession("session")
+
+			token, err := tu.issueRefreshTokenInFamily(ctx, tu.account, "session")
+			if err != nil {
+				t.Fatalf("issueRefreshTokenInFamily: %v", err)
+			}
+
+			tt.revoke(tu)
+
+			_, err = tu.refreshTokens(ctx, token.Value)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("refreshTokens() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	sessionModels "github.com/barugoo/oscillo-auth/internal/app/session"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+const (
+	revokedTokenKeyTemplate     = "revoked_token:%s"
+	revokedSessionKeyTemplate   = "revoked_session:%s"
+	tokensValidAfterKeyTemplate = "tokens_valid_after:%s"
+
+	sessionTouchInterval = time.Minute
+)
+
+func (uc *tokenUsecase) ParseAccessToken(ctx context.Context, accessToken string) (*models.Claims, error) {
+	methodName := uc.getMethodFromContext(ctx, "ParseAccessToken")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	claims, err := uc.parseAccessToken(ctx, accessToken)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return claims, err
+}
+
+func (uc *tokenUsecase) parseAccessToken(ctx context.Context, accessToken string) (*models.Claims, error) {
+	claims, err := uc.parseClaims(accessToken, models.UseAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.checkRevoked(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.checkSession(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (uc *tokenUsecase) checkSession(ctx context.Context, claims *models.Claims) error {
+	session, err := uc.sessionRep.GetSession(ctx, claims.Subject, claims.SessionID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return errs.ErrSessionRevoked
+	}
+	if err != nil {
+		return err
+	}
+	return uc.touchSession(ctx, session)
+}
+
+// touchSession marks the session as used now, ending it instead if it was
+// idle for too long. The write is skipped if the session was seen recently,
+// validation runs on every request.
+func (uc *tokenUsecase) touchSession(ctx context.Context, session *sessionModels.Session) error {
+	now := time.Now().UTC()
+
+	if session.IsIdle(now, uc.config.SessionIdleTimeout) {
+		_, err := uc.sessionRep.DeleteSessions(ctx, session.AccountID, session.ID)
+		if err != nil {
+			return err
+		}
+		return errs.ErrSessionRevoked
+	}
+
+	if now.Sub(session.LastSeenAt) < sessionTouchInterval {
+		return nil
+	}
+
+	ok, err := uc.sessionRep.TouchSession(ctx, session, now)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrSessionRevoked
+	}
+	return nil
+}
+
+func (uc *tokenUsecase) checkRevoked(ctx context.Context, claims *models.Claims) error {
+	_, err := uc.service.GetKV(ctx, fmt.Sprintf(revokedTokenKeyTemplate, claims.Id))
+	if err == nil {
+		return errs.ErrTokenRevoked
+	}
+	if !errors.Is(err, errs.ErrNotFound) {
+		return err
+	}
+
+	if claims.SessionID != "" {
+		_, err = uc.service.GetKV(ctx, fmt.Sprintf(revokedSessionKeyTemplate, claims.SessionID))
+		if err == nil {
+			return errs.ErrTokenRevoked
+		}
+		if !errors.Is(err, errs.ErrNotFound) {
+			return err
+		}
+	}
+
+	validAfter, err := uc.tokensValidAfter(ctx, claims.Subject)
+	if err != nil {
+		return err
+	}
+
+	// compared in milliseconds, so a token issued right after a revocation
+	// within the same second stays valid
+	if !validAfter.IsZero() && claims.IssuedAtMillis() <= unixMillis(validAfter) {
+		return errs.ErrTokenRevoked
+	}
+	return nil
+}
+
+func (uc *tokenUsecase) tokensValidAfter(ctx context.Context, accountID string) (time.Time, error) {
+	value, err := uc.service.GetKV(ctx, fmt.Sprintf(tokensValidAfterKeyTemplate, accountID))
+	if errors.Is(err, errs.ErrNotFound) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339Nano, value)
+}
+
+func (uc *tokenUsecase) Logout(ctx context.Context, accessToken, refreshToken string) error {
+	methodName := uc.getMethodFromContext(ctx, "Logout")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	err := uc.logout(ctx, accessToken, refreshToken)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return err
+}
+
+func (uc *tokenUsecase) logout(ctx context.Context, accessToken, refreshToken string) error {
+	claims, err := uc.parseAccessToken(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	if refreshToken != "" {
+		record, err := uc.getRefreshToken(ctx, hashToken(refreshToken))
+		if err != nil {
+			return err
+		}
+		if record.AccountID != claims.Subject {
+			return errs.ErrInvalidRefreshToken
+		}
+
+		_, err = uc.revokeRefreshFamily(ctx, record.FamilyID)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = uc.sessionRep.DeleteSessions(ctx, claims.Subject, claims.SessionID)
+	if err != nil {
+		return err
+	}
+
+	return uc.revokeToken(ctx, claims)
+}
+
+func (uc *tokenUsecase) revokeToken(ctx context.Context, claims *models.Claims) error {
+	ttl := time.Until(time.Unix(claims.ExpiresAt, 0))
+	if ttl <= 0 {
+		return nil
+	}
+
+	_, err := uc.service.SetKVWithTTL(ctx, fmt.Sprintf(revokedTokenKeyTemplate, claims.Id), claims.Subject, ttl)
+	return err
+}
+
+// revokeSession ends a session and denies every access token issued for it,
+// not only the one at hand. The marker lives as long as those tokens can.
+func (uc *tokenUsecase) revokeSession(ctx context.Context, accountID, sessionID string) error {
+	_, err := uc.sessionRep.DeleteSessions(ctx, accountID, sessionID)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.service.SetKVWithTTL(ctx, fmt.Sprintf(revokedSessionKeyTemplate, sessionID), accountID, uc.config.AccessTokenTTL)
+	return err
+}
+
+func (uc *tokenUsecase) RevokeAllTokens(ctx context.Context, accessToken string) error {
+	methodName := uc.getMethodFromContext(ctx, "RevokeAllTokens")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	err := uc.revokeAllTokens(ctx, accessToken)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return err
+}
+
+func (uc *tokenUsecase) revokeAllTokens(ctx context.Context, accessToken string) error {
+	claims, err := uc.parseAccessToken(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+	return uc.revokeAccountTokens(ctx, claims.Subject)
+}
+
+func (uc *tokenUsecase) RevokeAccountTokens(ctx context.Context, accountID string) error {
+	methodName := uc.getMethodFromContext(ctx, "RevokeAccountTokens")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	err := uc.revokeAccountTokens(ctx, accountID)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return err
+}
+
+func (uc *tokenUsecase) revokeAccountTokens(ctx context.Context, accountID string) error {
+	ttl := uc.config.AccessTokenTTL
+	if uc.config.RefreshTokenTTL > ttl {
+		ttl = uc.config.RefreshTokenTTL
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+
+	_, err := uc.service.SetKVWithTTL(ctx, fmt.Sprintf(tokensValidAfterKeyTemplate, accountID), now, ttl)
+	return err
+}
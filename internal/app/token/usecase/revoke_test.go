@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+func TestCheckRevokedValidAfter(t *testing.T) {
+	ctx := context.Background()
+	tu := newTestUsecase(t, testConfig())
+
+	// a cutoff in the middle of a second
+	cutoff := time.Date(2020, 1, 2, 3, 4, 5, 500*int(time.Millisecond), time.UTC)
+	tu.service.kv[fmt.Sprintf(tokensValidAfterKeyTemplate, tu.account.ID)] = cutoff.Format(time.RFC3339Nano)
+
+	claims := func(issuedAt time.Time, ms bool) *models.Claims {
+		c := &models.Claims{}
+		c.Id = "token"
+		c.Subject = tu.account.ID
+		c.IssuedAt = issuedAt.Unix()
+		if ms {
+			c.IssuedAtMs = unixMillis(issuedAt)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name   string
+		claims *models.Claims
+		err    error
+	}{
+		{"before", claims(cutoff.Add(-time.Hour), true), errs.ErrTokenRevoked},
+		{"same second, before", claims(cutoff.Add(-100*time.Millisecond), true), errs.ErrTokenRevoked},
+		{"at the cutoff", claims(cutoff, true), errs.ErrTokenRevoked},
+		{"same second, after", claims(cutoff.Add(100*time.Millisecond), true), nil},
+		{"after", claims(cutoff.Add(time.Hour), true), nil},
+		// tokens without iat_ms count as issued at the start of their second
+		{"seconds only, same second", claims(cutoff.Add(100*time.Millisecond), false), errs.ErrTokenRevoked},
+		{"seconds only, next second", claims(cutoff.Add(time.Second), false), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tu.checkRevoked(ctx, tt.claims)
+			if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+				t.Errorf("checkRevoked() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestCheckRevoked(t *testing.T) {
+	ctx := context.Background()
+	tu := newTestUsecase(t, testConfig())
+
+	tu.service.kv[fmt.Sprintf(revokedTokenKeyTemplate, "revoked")] = tu.account.ID
+	tu.service.kv[fmt.Sprintf(revokedSessionKeyTemplate, "revoked")] = tu.account.ID
+
+	tests := []struct {
+		name      string
+		id        string
+		sessionID string
+		err       error
+	}{
+		{"valid", "token", "session", nil},
+		{"no session", "token", "", nil},
+		{"revoked token", "revoked", "session", errs.ErrTokenRevoked},
+		{"revoked session", "token", "revoked", errs.ErrTokenRevoked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := &models.Claims{SessionID: tt.sessionID}
+			claims.Id = tt.id
+			claims.Subject = tu.account.ID
+			claims.IssuedAt = time.Now().Unix()
+
+			err := tu.checkRevoked(ctx, claims)
+			if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+				t.Errorf("checkRevoked() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestParseAccessTokenSession(t *testing.T) {
+	ctx := context.Background()
+	tu := newTestUsecase(t, testConfig())
+
+	tests := []struct {
+		name  string
+		setup func() string
+		err   error
+	}{
+		{
+			name: "active session",
+			setup: func() string {
+				return tu.newSession("active").ID
+			},
+		},
+		{
+			name: "deleted session",
+			setup: func() string {
+				return "deleted"
+			},
+			err: errs.ErrSessionRevoked,
+		},
+		{
+			name: "idle session",
+			setup: func() string {
+				session := tu.newSession("idle")
+				session.LastSeenAt = time.Now().Add(-tu.config.SessionIdleTimeout - time.Minute)
+				return session.ID
+			},
+			err: errs.ErrSessionRevoked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionID := tt.setup()
+
+			token, err := tu.issueAccessToken(tu.account, sessionID)
+			if err != nil {
+				t.Fatalf("issueAccessToken: %v", err)
+			}
+
+			_, err = tu.parseAccessToken(ctx, token.Value)
+			if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+				t.Errorf("parseAccessToken() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	if _, ok := tu.sessions.sessions["idle"]; ok {
+		t.Errorf("idle session wasn't deleted")
+	}
+}
@@ -0,0 +1,348 @@
+package usecase
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/barugoo/oscillo-auth/config"
+
+	"github.com/barugoo/oscillo-auth/internal/app/errors"
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+
+	accountModels "github.com/barugoo/oscillo-auth/internal/app/account"
+	accountRepository "github.com/barugoo/oscillo-auth/internal/app/account/repository"
+
+	sessionRepository "github.com/barugoo/oscillo-auth/internal/app/session/repository"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+	"github.com/barugoo/oscillo-auth/internal/app/token/repository"
+)
+
+type TokenUsecase interface {
+	IssueAccessToken(ctx context.Context, account *accountModels.Account, sessionID string) (*models.Token, error)
+	IssueRefreshToken(ctx context.Context, account *accountModels.Account, sessionID string) (*models.Token, error)
+	IssueChallengeToken(ctx context.Context, account *accountModels.Account) (*models.Token, error)
+	ConsumeChallengeToken(ctx context.Context, challengeToken string) (*models.Claims, error)
+	RefreshTokens(ctx context.Context, refreshToken string) (*models.TokenPair, error)
+	ParseAccessToken(ctx context.Context, accessToken string) (*models.Claims, error)
+	ValidateToken(ctx context.Context, accessToken string) (*models.Claims, error)
+	ValidateTokens(ctx context.Context, accessTokens []string) ([]*models.Validation, error)
+	Logout(ctx context.Context, accessToken, refreshToken string) error
+	RevokeAllTokens(ctx context.Context, accessToken string) error
+	RevokeAccountTokens(ctx context.Context, accountID string) error
+	GetJWKS(ctx context.Context) (*models.JWKS, error)
+	RotateKeys(ctx context.Context) error
+}
+
+const (
+	usecaseMethodTemplate = "%s/usecase"
+
+	keyRotationLockKey = "key_rotation_lock"
+	keyRotationLockTTL = 30 * time.Second
+)
+
+type tokenUsecase struct {
+	service    service.AuthService
+	config     *config.ServiceConfig
+	repository repository.KeyRepository
+	accountRep accountRepository.AccountRepository
+	sessionRep sessionRepository.SessionRepository
+
+	encryption *keyEncryption
+
+	mu        sync.RWMutex
+	signing   *signingKey
+	published []*signingKey
+}
+
+func NewTokenUsecase(config *config.ServiceConfig, service service.AuthService, repository repository.KeyRepository, accountRepository accountRepository.AccountRepository, sessionRepository sessionRepository.SessionRepository) (TokenUsecase, error) {
+	encryption, err := newKeyEncryption(config.SigningKeyEncryptionKeys, config.SigningKeyEncryptionVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokenUsecase{
+		config:     config,
+		service:    service,
+		repository: repository,
+		accountRep: accountRepository,
+		sessionRep: sessionRepository,
+		encryption: encryption,
+	}, nil
+}
+
+func (uc *tokenUsecase) IssueAccessToken(ctx context.Context, account *accountModels.Account, sessionID string) (*models.Token, error) {
+	methodName := uc.getMethodFromContext(ctx, "IssueAccessToken")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	token, err := uc.issueAccessToken(account, sessionID)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return token, err
+}
+
+func (uc *tokenUsecase) issueAccessToken(account *accountModels.Account, sessionID string) (*models.Token, error) {
+	claims, err := uc.newClaims(account, sessionID, models.UseAccess, uc.config.AccessTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := uc.signToken(claims)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Token{
+		Value:     value,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+	}, nil
+}
+
+func (uc *tokenUsecase) signToken(claims jwt.Claims) (string, error) {
+	uc.mu.RLock()
+	key := uc.signing
+	uc.mu.RUnlock()
+
+	if key == nil {
+		return "", errors.ErrNoSigningKey
+	}
+
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.id
+	return token.SignedString(key.private)
+}
+
+func (uc *tokenUsecase) GetJWKS(ctx context.Context) (*models.JWKS, error) {
+	methodName := uc.getMethodFromContext(ctx, "GetJWKS")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	jwks, err := uc.getJWKS()
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return jwks, err
+}
+
+func (uc *tokenUsecase) getJWKS() (*models.JWKS, error) {
+	uc.mu.RLock()
+	published := uc.published
+	uc.mu.RUnlock()
+
+	jwks := &models.JWKS{
+		Keys: make([]models.JWK, 0, len(published)),
+	}
+	for _, key := range published {
+		jwk, err := key.jwk()
+		if err != nil {
+			return nil, err
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	return jwks, nil
+}
+
+func (uc *tokenUsecase) RotateKeys(ctx context.Context) error {
+	methodName := uc.getMethodFromContext(ctx, "RotateKeys")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	err := uc.rotateKeys(ctx)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return err
+}
+
+// rotateKeys creates the next key ahead of time: it's published for
+// publishLead before it takes over signing, so clients caching the JWKS and
+// the other instances have it by then. Only one instance creates keys, the
+// others pick them up on their next run.
+func (uc *tokenUsecase) rotateKeys(ctx context.Context) error {
+	now := time.Now().UTC()
+
+	keys, err := uc.repository.GetKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	if uc.needsKey(keys, now) {
+		owner, err := generateRandomID()
+		if err != nil {
+			return err
+		}
+		ok, err := uc.service.SetKVNX(ctx, keyRotationLockKey, owner, keyRotationLockTTL)
+		if err != nil {
+			return err
+		}
+		if ok {
+			defer uc.service.DelKVIfEqual(ctx, keyRotationLockKey, owner)
+
+			keys, err = uc.createNextKey(ctx, now)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return uc.loadKeys(keys, now)
+}
+
+// needsKey tells whether there is no key to sign with once a key created
+// now would take over, checked one refresh interval ahead since that's when
+// the next run comes.
+func (uc *tokenUsecase) needsKey(keys []*models.Key, now time.Time) bool {
+	horizon := now.Add(uc.publishLead() + uc.config.KeyRefreshInterval)
+	return !uc.hasSigningKey(keys, now) || !uc.hasSigningKey(keys, horizon)
+}
+
+// createNextKey runs under the rotation lock. It reloads the keys, another
+// instance may have created the key in the meantime, and returns them with
+// the new key if one was needed.
+func (uc *tokenUsecase) createNextKey(ctx context.Context, now time.Time) ([]*models.Key, error) {
+	_, err := uc.repository.DeleteExpiredKeys(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := uc.repository.GetKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !uc.needsKey(keys, now) {
+		return keys, nil
+	}
+
+	// the new key takes over when the current one retires. Without a current
+	// key nothing can be signed, so it takes over right away.
+	activatesAt := now
+	for _, key := range keys {
+		if uc.canSign(key, now) && key.RetiresAt.After(activatesAt) {
+			activatesAt = key.RetiresAt
+		}
+	}
+
+	key, err := uc.newKey(now, activatesAt)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err = uc.repository.CreateKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return append([]*models.Key{key}, keys...), nil
+}
+
+func (uc *tokenUsecase) publishLead() time.Duration {
+	return uc.config.JWKSMaxAge + uc.config.KeyRefreshInterval
+}
+
+func (uc *tokenUsecase) hasSigningKey(keys []*models.Key, now time.Time) bool {
+	for _, key := range keys {
+		if uc.canSign(key, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// canSign leaves keys stored unencrypted to verification, whoever got a copy
+// of them mustn't be able to sign tokens that are still accepted later on.
+func (uc *tokenUsecase) canSign(key *models.Key, now time.Time) bool {
+	return key.Algorithm == uc.config.SigningAlgorithm && key.IsEncrypted() && key.IsSigning(now)
+}
+
+func (uc *tokenUsecase) newKey(now, activatesAt time.Time) (*models.Key, error) {
+	id, err := generateRandomID()
+	if err != nil {
+		return nil, err
+	}
+
+	private, err := generatePrivateKey(uc.config.SigningAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, err
+	}
+
+	retiresAt := activatesAt.Add(uc.config.KeyRotationPeriod)
+	key := &models.Key{
+		ID:          id,
+		Algorithm:   uc.config.SigningAlgorithm,
+		CreatedAt:   now,
+		ActivatesAt: activatesAt,
+		RetiresAt:   retiresAt,
+		ExpiresAt:   retiresAt.Add(uc.config.KeyRetentionPeriod),
+	}
+
+	err = uc.encryption.seal(key, der)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// keys are expected newest first, so the first signing key found is the
+// one tokens get signed with while older ones stay published for verifiers.
+func (uc *tokenUsecase) loadKeys(keys []*models.Key, now time.Time) error {
+	var signing *signingKey
+	published := make([]*signingKey, 0, len(keys))
+
+	for _, key := range keys {
+		if !key.IsPublished(now) {
+			continue
+		}
+
+		der, err := uc.encryption.open(key)
+		if err != nil {
+			return err
+		}
+
+		parsed, err := parseSigningKey(key, der)
+		if err != nil {
+			return err
+		}
+		published = append(published, parsed)
+
+		if signing == nil && uc.canSign(key, now) {
+			signing = parsed
+		}
+	}
+
+	uc.mu.Lock()
+	uc.signing = signing
+	uc.published = published
+	uc.mu.Unlock()
+	return nil
+}
+
+func (uc *tokenUsecase) wrapError(err error, method string) error {
+	return &errors.UsecaseError{
+		Method: method,
+		Err:    err,
+	}
+}
+
+// the token usecase is also called from other usecases and background jobs,
+// which don't carry a delivery method in their context.
+func (uc *tokenUsecase) getMethodFromContext(ctx context.Context, name string) string {
+	if method, ok := ctx.Value("method").(string); ok {
+		name = method
+	}
+	return fmt.Sprintf(usecaseMethodTemplate, name)
+}
@@ -0,0 +1,421 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	opentracing "github.com/opentracing/opentracing-go"
+
+	"github.com/barugoo/oscillo-auth/config"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+	"github.com/barugoo/oscillo-auth/internal/app/service"
+
+	accountModels "github.com/barugoo/oscillo-auth/internal/app/account"
+	accountRepository "github.com/barugoo/oscillo-auth/internal/app/account/repository"
+
+	sessionModels "github.com/barugoo/oscillo-auth/internal/app/session"
+	sessionRepository "github.com/barugoo/oscillo-auth/internal/app/session/repository"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+// fakeService keeps the key-value store in a map. TTLs aren't enforced, the
+// tests don't run long enough for them to matter.
+type fakeService struct {
+	mu sync.Mutex
+	kv map[string]string
+}
+
+var _ service.AuthService = (*fakeService)(nil)
+
+func newFakeService() *fakeService {
+	return &fakeService{kv: make(map[string]string)}
+}
+
+func (s *fakeService) SetKV(ctx context.Context, key, value string) (bool, error) {
+	return s.SetKVWithTTL(ctx, key, value, 0)
+}
+
+func (s *fakeService) GetKV(ctx context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.kv[key]
+	if !ok {
+		return "", &errs.ServiceError{Method: "GetKV/fake", Err: errs.ErrNotFound}
+	}
+	return value, nil
+}
+
+func (s *fakeService) SetKVWithTTL(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.kv[key] = value
+	return true, nil
+}
+
+func (s *fakeService) SetKVNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.kv[key]; ok {
+		return false, nil
+	}
+	s.kv[key] = value
+	return true, nil
+}
+
+func (s *fakeService) DelKV(ctx context.Context, keys ...string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int64
+	for _, key := range keys {
+		if _, ok := s.kv[key]; ok {
+			delete(s.kv, key)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (s *fakeService) DelKVIfEqual(ctx context.Context, key, value string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.kv[key] != value {
+		return false, nil
+	}
+	delete(s.kv, key)
+	return true, nil
+}
+
+func (s *fakeService) IncrKV(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, _ := strconv.ParseInt(s.kv[key], 10, 64)
+	n += delta
+	s.kv[key] = strconv.FormatInt(n, 10)
+	return n, nil
+}
+
+func (s *fakeService) StartSpan(ctx context.Context, name string) opentracing.Span {
+	return opentracing.NoopTracer{}.StartSpan(name)
+}
+
+func (s *fakeService) ContextWithSpan(ctx context.Context, span opentracing.Span) context.Context {
+	return opentracing.ContextWithSpan(ctx, span)
+}
+
+func (s *fakeService) has(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.kv[key]
+	return ok
+}
+
+type fakeKeyRepository struct {
+	keys []*models.Key
+}
+
+func (r *fakeKeyRepository) GetKeys(ctx context.Context) ([]*models.Key, error) {
+	keys := make([]*models.Key, len(r.keys))
+	copy(keys, r.keys)
+	return keys, nil
+}
+
+func (r *fakeKeyRepository) CreateKey(ctx context.Context, key *models.Key) (*models.Key, error) {
+	r.keys = append([]*models.Key{key}, r.keys...)
+	return key, nil
+}
+
+func (r *fakeKeyRepository) DeleteExpiredKeys(ctx context.Context, now time.Time) (int64, error) {
+	kept := r.keys[:0]
+	for _, key := range r.keys {
+		if key.IsPublished(now) {
+			kept = append(kept, key)
+		}
+	}
+	n := int64(len(r.keys) - len(kept))
+	r.keys = kept
+	return n, nil
+}
+
+// fakeAccountRepository only looks accounts up, the token usecase doesn't
+// write them.
+type fakeAccountRepository struct {
+	accountRepository.AccountRepository
+	accounts map[string]*accountModels.Account
+}
+
+func (r *fakeAccountRepository) GetAccountByID(ctx context.Context, id string) (*accountModels.Account, error) {
+	account, ok := r.accounts[id]
+	if !ok {
+		return nil, &errs.RepositoryError{Impl: "fake", Err: errs.ErrNotFound}
+	}
+	return account, nil
+}
+
+type fakeSessionRepository struct {
+	sessionRepository.SessionRepository
+	sessions map[string]*sessionModels.Session
+}
+
+func (r *fakeSessionRepository) GetSession(ctx context.Context, accountID, sessionID string) (*sessionModels.Session, error) {
+	session, ok := r.sessions[sessionID]
+	if !ok || session.AccountID != accountID {
+		return nil, &errs.RepositoryError{Impl: "fake", Err: errs.ErrNotFound}
+	}
+	return session, nil
+}
+
+func (r *fakeSessionRepository) TouchSession(ctx context.Context, session *sessionModels.Session, at time.Time) (bool, error) {
+	stored, ok := r.sessions[session.ID]
+	if !ok {
+		return false, nil
+	}
+	stored.LastSeenAt = at
+	return true, nil
+}
+
+func (r *fakeSessionRepository) DeleteSessions(ctx context.Context, accountID string, sessionIDs ...string) (int64, error) {
+	var n int64
+	for _, id := range sessionIDs {
+		if session, ok := r.sessions[id]; ok && session.AccountID == accountID {
+			delete(r.sessions, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func testConfig() *config.ServiceConfig {
+	return &config.ServiceConfig{
+		SigningAlgorithm:   "ES256",
+		KeyRotationPeriod:  720 * time.Hour,
+		KeyRetentionPeriod: 24 * time.Hour,
+		KeyRefreshInterval: time.Minute,
+		JWKSMaxAge:         5 * time.Minute,
+		SigningKeyEncryptionKeys: map[string]string{
+			"v1": base64.StdEncoding.EncodeToString(make([]byte, encryptionKeyBytes)),
+		},
+		SigningKeyEncryptionVersion: "v1",
+		TokenIssuer:                 "auth",
+		TokenAudience:               "api",
+		AccessTokenTTL:              15 * time.Minute,
+		RefreshTokenTTL:             720 * time.Hour,
+		ChallengeTTL:                5 * time.Minute,
+		SessionIdleTimeout:          168 * time.Hour,
+	}
+}
+
+type testUsecase struct {
+	*tokenUsecase
+	service  *fakeService
+	keys     *fakeKeyRepository
+	sessions *fakeSessionRepository
+	account  *accountModels.Account
+}
+
+// newTestUsecase returns a usecase with a signing key loaded and one active
+// account.
+func newTestUsecase(t *testing.T, cfg *config.ServiceConfig) *testUsecase {
+	t.Helper()
+
+	account := &accountModels.Account{
+		ID:            "account",
+		Email:         "user@example.com",
+		EmailVerified: true,
+		Status:        accountModels.StatusActive,
+	}
+
+	tu := &testUsecase{
+		service:  newFakeService(),
+		keys:     &fakeKeyRepository{},
+		sessions: &fakeSessionRepository{sessions: make(map[string]*sessionModels.Session)},
+		account:  account,
+	}
+	accounts := &fakeAccountRepository{accounts: map[string]*accountModels.Account{account.ID: account}}
+
+	uc, err := NewTokenUsecase(cfg, tu.service, tu.keys, accounts, tu.sessions)
+	if err != nil {
+		t.Fatalf("NewTokenUsecase: %v", err)
+	}
+	tu.tokenUsecase = uc.(*tokenUsecase)
+
+	err = tu.rotateKeys(context.Background())
+	if err != nil {
+		t.Fatalf("rotateKeys: %v", err)
+	}
+	return tu
+}
+
+// newSession stores a session for the test account, as login does.
+func (tu *testUsecase) newSession(id string) *sessionModels.Session {
+	now := time.Now().UTC()
+	session := &sessionModels.Session{
+		ID:         id,
+		AccountID:  tu.account.ID,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+	tu.sessions.sessions[id] = session
+	return session
+}
+
+func TestRotateKeys(t *testing.T) {
+	ctx := context.Background()
+	tu := newTestUsecase(t, testConfig())
+
+	if len(tu.keys.keys) != 1 {
+		t.Fatalf("first run stored %d keys, want 1", len(tu.keys.keys))
+	}
+	first := tu.keys.keys[0]
+	if !first.IsEncrypted() {
+		t.Errorf("stored key isn't encrypted")
+	}
+	if tu.signing == nil || tu.signing.id != first.ID {
+		t.Fatalf("signing with %v, want the new key %s", tu.signing, first.ID)
+	}
+
+	err := tu.rotateKeys(ctx)
+	if err != nil {
+		t.Fatalf("rotateKeys: %v", err)
+	}
+	if len(tu.keys.keys) != 1 {
+		t.Errorf("second run stored %d keys, want still 1", len(tu.keys.keys))
+	}
+
+	// close to retirement the next key is created, but only published
+	first.RetiresAt = time.Now().UTC().Add(time.Minute)
+	err = tu.rotateKeys(ctx)
+	if err != nil {
+		t.Fatalf("rotateKeys: %v", err)
+	}
+	if len(tu.keys.keys) != 2 {
+		t.Fatalf("run before retirement stored %d keys, want 2", len(tu.keys.keys))
+	}
+	next := tu.keys.keys[0]
+	if !next.ActivatesAt.Equal(first.RetiresAt) {
+		t.Errorf("next key activates at %v, want %v", next.ActivatesAt, first.RetiresAt)
+	}
+	if tu.signing.id != first.ID {
+		t.Errorf("signing with %s before the current key retired, want %s", tu.signing.id, first.ID)
+	}
+	if len(tu.published) != 2 {
+		t.Errorf("published %d keys, want 2", len(tu.published))
+	}
+
+	// once the current key retired the next one signs
+	err = tu.loadKeys(tu.keys.keys, first.RetiresAt)
+	if err != nil {
+		t.Fatalf("loadKeys: %v", err)
+	}
+	if tu.signing.id != next.ID {
+		t.Errorf("signing with %s after retirement, want %s", tu.signing.id, next.ID)
+	}
+}
+
+func TestRotateKeysLocked(t *testing.T) {
+	ctx := context.Background()
+	tu := newTestUsecase(t, testConfig())
+	tu.keys.keys[0].RetiresAt = time.Now().UTC().Add(time.Minute)
+
+	// another instance is creating the next key
+	tu.service.kv[keyRotationLockKey] = "other"
+
+	err := tu.rotateKeys(ctx)
+	if err != nil {
+		t.Fatalf("rotateKeys: %v", err)
+	}
+	if len(tu.keys.keys) != 1 {
+		t.Errorf("stored %d keys while another instance held the lock, want 1", len(tu.keys.keys))
+	}
+	if tu.service.kv[keyRotationLockKey] != "other" {
+		t.Errorf("lock of the other instance was released")
+	}
+}
+
+func TestLoadKeysUnencrypted(t *testing.T) {
+	ctx := context.Background()
+	tu := newTestUsecase(t, testConfig())
+
+	// a key stored before encryption, decrypted in place
+	legacy := tu.keys.keys[0]
+	der, err := tu.encryption.open(legacy)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	legacy.PrivateKey = der
+	legacy.EncryptionKeyVersion = ""
+
+	err = tu.rotateKeys(ctx)
+	if err != nil {
+		t.Fatalf("rotateKeys: %v", err)
+	}
+	if len(tu.keys.keys) != 2 {
+		t.Fatalf("stored %d keys, want a new one next to the unencrypted", len(tu.keys.keys))
+	}
+	if tu.signing.id == legacy.ID {
+		t.Errorf("signing with the unencrypted key")
+	}
+	if len(tu.published) != 2 {
+		t.Errorf("published %d keys, want the unencrypted one too", len(tu.published))
+	}
+}
+
+func TestKeyEncryption(t *testing.T) {
+	cfg := testConfig()
+	e, err := newKeyEncryption(cfg.SigningKeyEncryptionKeys, cfg.SigningKeyEncryptionVersion)
+	if err != nil {
+		t.Fatalf("newKeyEncryption: %v", err)
+	}
+
+	key := &models.Key{ID: "a"}
+	err = e.seal(key, []byte("private"))
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+
+	moved := *key
+	moved.ID = "b"
+	if _, err := e.open(&moved); err == nil {
+		t.Errorf("open() of a key moved to another ID succeeded")
+	}
+
+	unknown := *key
+	unknown.EncryptionKeyVersion = "v2"
+	if _, err := e.open(&unknown); !errors.Is(err, errs.ErrUnknownEncryptionKey) {
+		t.Errorf("open() with unknown version error = %v, want %v", err, errs.ErrUnknownEncryptionKey)
+	}
+
+	der, err := e.open(key)
+	if err != nil || string(der) != "private" {
+		t.Errorf("open() = %q, %v, want %q", der, err, "private")
+	}
+
+	configs := []struct {
+		name    string
+		keys    map[string]string
+		current string
+	}{
+		{"no key", nil, ""},
+		{"unknown current", cfg.SigningKeyEncryptionKeys, "v2"},
+		{"short key", map[string]string{"v1": base64.StdEncoding.EncodeToString(make([]byte, 16))}, "v1"},
+		{"not base64", map[string]string{"v1": "!"}, "v1"},
+	}
+	for _, c := range configs {
+		if _, err := newKeyEncryption(c.keys, c.current); err == nil {
+			t.Errorf("%s: newKeyEncryption() succeeded", c.name)
+		}
+	}
+}
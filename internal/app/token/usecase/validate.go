@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errs "github.com/barugoo/oscillo-auth/internal/app/errors"
+
+	models "github.com/barugoo/oscillo-auth/internal/app/token"
+)
+
+func (uc *tokenUsecase) ValidateToken(ctx context.Context, accessToken string) (*models.Claims, error) {
+	methodName := uc.getMethodFromContext(ctx, "ValidateToken")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	claims, err := uc.validateToken(ctx, accessToken)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return claims, err
+}
+
+func (uc *tokenUsecase) validateToken(ctx context.Context, accessToken string) (*models.Claims, error) {
+	claims, err := uc.parseAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := uc.accountRep.GetAccountByID(ctx, claims.Subject)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errs.ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = account.CheckStatus(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (uc *tokenUsecase) ValidateTokens(ctx context.Context, accessTokens []string) ([]*models.Validation, error) {
+	methodName := uc.getMethodFromContext(ctx, "ValidateTokens")
+
+	span := uc.service.StartSpan(ctx, methodName)
+	defer span.Finish()
+
+	ctx = uc.service.ContextWithSpan(context.Background(), span)
+
+	validations, err := uc.validateTokens(ctx, accessTokens)
+	if err != nil {
+		err = uc.wrapError(err, methodName)
+	}
+	return validations, err
+}
+
+func (uc *tokenUsecase) validateTokens(ctx context.Context, accessTokens []string) ([]*models.Validation, error) {
+	if len(accessTokens) > uc.config.ValidateBatchSize {
+		return nil, errs.ErrBatchTooLarge
+	}
+
+	// each token fails on its own, as if it was validated with ValidateToken
+	methodName := uc.getMethodFromContext(ctx, "ValidateToken")
+
+	validations := make([]*models.Validation, 0, len(accessTokens))
+	for _, accessToken := range accessTokens {
+		claims, err := uc.validateToken(ctx, accessToken)
+		if err != nil {
+			err = uc.wrapError(err, methodName)
+		}
+		validations = append(validations, &models.Validation{
+			Claims: claims,
+			Err:    err,
+		})
+	}
+	return validations, nil
+}
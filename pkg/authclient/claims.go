@@ -0,0 +1,53 @@
+package authclient
+
+import (
+	"context"
+
+	"github.com/dgrijalva/jwt-go"
+
+	pb "github.com/barugoo/oscillo-auth/api/grpc"
+)
+
+const (
+	useAccess = "access"
+)
+
+type Claims struct {
+	jwt.StandardClaims
+	Use       string `json:"token_use"`
+	SessionID string `json:"sid,omitempty"`
+	Email     string `json:"email"`
+	Has2FA    bool   `json:"has_2fa"`
+}
+
+func claimsFromProto(c *pb.TokenClaims) *Claims {
+	return &Claims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        c.Id,
+			Issuer:    c.Issuer,
+			Subject:   c.Subject,
+			Audience:  c.Audience,
+			IssuedAt:  c.IssuedAt,
+			NotBefore: c.NotBefore,
+			ExpiresAt: c.ExpiresAt,
+		},
+		Use:       useAccess,
+		SessionID: c.SessionId,
+		Email:     c.Email,
+		Has2FA:    c.Has_2Fa,
+	}
+}
+
+type claimsKey struct{}
+
+// NewContext returns a copy of ctx carrying verified claims.
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// FromContext returns the claims put into ctx by the interceptors or the
+// HTTP middleware.
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
@@ -0,0 +1,116 @@
+// Package authclient wraps the generated Auth gRPC client and verifies
+// access tokens issued by the auth service, either locally against the
+// published JWKS or remotely through ValidateToken.
+package authclient
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/barugoo/oscillo-auth/api/grpc"
+)
+
+var (
+	ErrMissingToken = errors.New("missing token")
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+// Verifier checks an access token and returns its claims.
+type Verifier interface {
+	Verify(ctx context.Context, token string) (*Claims, error)
+}
+
+type Client struct {
+	pb.AuthClient
+	verifier Verifier
+}
+
+type options struct {
+	remote        bool
+	issuer        string
+	audience      string
+	jwksTTL       time.Duration
+	jwksMinReload time.Duration
+	cacheTTL      time.Duration
+	cacheSize     int
+}
+
+type Option func(*options)
+
+// WithRemoteValidation makes the client verify tokens with the ValidateToken
+// RPC, which also honours revocation and account state, instead of
+// checking signatures locally.
+func WithRemoteValidation(cacheTTL time.Duration) Option {
+	return func(o *options) {
+		o.remote = true
+		o.cacheTTL = cacheTTL
+	}
+}
+
+// WithCacheSize bounds the number of remote validation results kept.
+func WithCacheSize(size int) Option {
+	return func(o *options) {
+		o.cacheSize = size
+	}
+}
+
+// WithJWKSRefresh sets how long fetched keys are trusted before reloading.
+func WithJWKSRefresh(ttl time.Duration) Option {
+	return func(o *options) {
+		o.jwksTTL = ttl
+	}
+}
+
+// WithIssuer requires tokens to carry the given iss claim.
+func WithIssuer(issuer string) Option {
+	return func(o *options) {
+		o.issuer = issuer
+	}
+}
+
+// WithAudience requires tokens to carry the given aud claim.
+func WithAudience(audience string) Option {
+	return func(o *options) {
+		o.audience = audience
+	}
+}
+
+func New(conn *grpc.ClientConn, opts ...Option) *Client {
+	return NewWithAuthClient(pb.NewAuthClient(conn), opts...)
+}
+
+// NewWithAuthClient is like New but takes an already constructed client,
+// e.g. one with its own call options or a fake in tests.
+func NewWithAuthClient(client pb.AuthClient, opts ...Option) *Client {
+	o := &options{
+		jwksTTL:       5 * time.Minute,
+		jwksMinReload: 10 * time.Second,
+		cacheTTL:      30 * time.Second,
+		cacheSize:     10000,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	var verifier Verifier
+	if o.remote {
+		verifier = newRemoteVerifier(client, o)
+	} else {
+		verifier = newJWKSVerifier(client, o)
+	}
+
+	return &Client{
+		AuthClient: client,
+		verifier:   verifier,
+	}
+}
+
+func (c *Client) Verify(ctx context.Context, token string) (*Claims, error) {
+	if token == "" {
+		return nil, ErrMissingToken
+	}
+	return c.verifier.Verify(ctx, token)
+}
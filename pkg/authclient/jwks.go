@@ -0,0 +1,172 @@
+package authclient
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	pb "github.com/barugoo/oscillo-auth/api/grpc"
+	"github.com/barugoo/oscillo-auth/pkg/eddsa"
+)
+
+type jwksVerifier struct {
+	client  pb.AuthClient
+	options *options
+
+	mu       sync.RWMutex
+	keys     map[string]interface{}
+	loadedAt time.Time
+}
+
+func newJWKSVerifier(client pb.AuthClient, o *options) *jwksVerifier {
+	return &jwksVerifier{
+		client:  client,
+		options: o,
+	}
+}
+
+func (v *jwksVerifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	claims := &Claims{}
+
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if claims.Use != useAccess {
+		return nil, fmt.Errorf("%w: unexpected token use %q", ErrInvalidToken, claims.Use)
+	}
+	if !claims.VerifyIssuer(v.options.issuer, v.options.issuer != "") {
+		return nil, fmt.Errorf("%w: unexpected issuer", ErrInvalidToken)
+	}
+	if !claims.VerifyAudience(v.options.audience, v.options.audience != "") {
+		return nil, fmt.Errorf("%w: unexpected audience", ErrInvalidToken)
+	}
+	return claims, nil
+}
+
+// key looks a kid up in the cached key set and reloads the set when it is
+// stale or the kid is unknown, e.g. right after the service rotated keys.
+func (v *jwksVerifier) key(ctx context.Context, kid string) (interface{}, error) {
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	fresh := time.Since(v.loadedAt) < v.options.jwksTTL
+	recent := time.Since(v.loadedAt) < v.options.jwksMinReload
+	v.mu.RUnlock()
+
+	if ok && fresh {
+		return key, nil
+	}
+	if !ok && recent {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+
+	err := v.reload(ctx)
+	if err != nil {
+		if ok {
+			return key, nil
+		}
+		return nil, err
+	}
+
+	v.mu.RLock()
+	key, ok = v.keys[kid]
+	v.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+	return key, nil
+}
+
+func (v *jwksVerifier) reload(ctx context.Context) error {
+	resp, err := v.client.GetJWKS(ctx, &pb.GetJWKSRequest{})
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]interface{}, len(resp.Keys))
+	for _, jwk := range resp.Keys {
+		key, err := publicKey(jwk)
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	v.mu.Lock()
+	v.keys = keys
+	v.loadedAt = time.Now()
+	v.mu.Unlock()
+	return nil
+}
+
+func publicKey(jwk *pb.JWK) (interface{}, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if jwk.Crv != "Ed25519" || jwk.Alg != eddsa.SigningMethodEdDSA.Alg() {
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key size %d", len(x))
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", jwk.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
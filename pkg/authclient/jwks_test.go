@@ -0,0 +1,137 @@
+package authclient
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc"
+
+	pb "github.com/barugoo/oscillo-auth/api/grpc"
+	"github.com/barugoo/oscillo-auth/pkg/eddsa"
+)
+
+// fakeJWKS serves a mutable key set and counts how often it was fetched.
+type fakeJWKS struct {
+	pb.AuthClient
+	keys  []*pb.JWK
+	calls int
+}
+
+func (f *fakeJWKS) GetJWKS(ctx context.Context, in *pb.GetJWKSRequest, opts ...grpc.CallOption) (*pb.GetJWKSResponse, error) {
+	f.calls++
+	return &pb.GetJWKSResponse{Keys: f.keys}, nil
+}
+
+type testKey struct {
+	kid  string
+	priv ed25519.PrivateKey
+	jwk  *pb.JWK
+}
+
+func newTestKey(t *testing.T, kid string) *testKey {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	return &testKey{
+		kid:  kid,
+		priv: priv,
+		jwk: &pb.JWK{
+			Kty: "OKP",
+			Kid: kid,
+			Use: "sig",
+			Alg: eddsa.SigningMethodEdDSA.Alg(),
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(pub),
+		},
+	}
+}
+
+func (k *testKey) sign(t *testing.T) string {
+	t.Helper()
+	token := jwt.NewWithClaims(eddsa.SigningMethodEdDSA, &Claims{
+		StandardClaims: jwt.StandardClaims{
+			Subject:   "account",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+		},
+		Use: useAccess,
+	})
+	token.Header["kid"] = k.kid
+	signed, err := token.SignedString(k.priv)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+	return signed
+}
+
+func TestJWKSVerifierReload(t *testing.T) {
+	ctx := context.Background()
+	current := newTestKey(t, "current")
+	next := newTestKey(t, "next")
+
+	tests := []struct {
+		name string
+		// loadedAgo is how long before the check the set was loaded
+		loadedAgo time.Duration
+		token     *testKey
+		wantErr   bool
+		wantCalls int
+	}{
+		{"known kid, fresh set", time.Second, current, false, 0},
+		{"known kid, stale set", 10 * time.Minute, current, false, 1},
+		{"unknown kid after rotation", time.Minute, next, false, 1},
+		{"unknown kid right after a reload", time.Second, next, true, 0},
+	}
+
+	for _, tt := range tests {
+		client := &fakeJWKS{keys: []*pb.JWK{current.jwk, next.jwk}}
+		v := newJWKSVerifier(client, &options{
+			jwksTTL:       5 * time.Minute,
+			jwksMinReload: 10 * time.Second,
+		})
+		// the cached set predates the rotation and only knows the current key
+		v.keys = map[string]interface{}{current.kid: current.priv.Public()}
+		v.loadedAt = time.Now().Add(-tt.loadedAgo)
+
+		claims, err := v.Verify(ctx, tt.token.sign(t))
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("%s: Verify() error = %v, want %v", tt.name, err, ErrInvalidToken)
+			}
+		} else if err != nil || claims.Subject != "account" {
+			t.Errorf("%s: Verify() = %v, %v, want subject %q", tt.name, claims, err, "account")
+		}
+		if client.calls != tt.wantCalls {
+			t.Errorf("%s: GetJWKS called %d times, want %d", tt.name, client.calls, tt.wantCalls)
+		}
+	}
+}
+
+func TestJWKSVerifierRetiredKey(t *testing.T) {
+	ctx := context.Background()
+	retired := newTestKey(t, "retired")
+
+	client := &fakeJWKS{}
+	v := newJWKSVerifier(client, &options{
+		jwksTTL:       5 * time.Minute,
+		jwksMinReload: 10 * time.Second,
+	})
+
+	token := retired.sign(t)
+	for i := 0; i < 3; i++ {
+		if _, err := v.Verify(ctx, token); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("Verify() error = %v, want %v", err, ErrInvalidToken)
+		}
+	}
+	// the first miss reloads, the following ones are throttled
+	if client.calls != 1 {
+		t.Errorf("GetJWKS called %d times, want 1", client.calls)
+	}
+}
@@ -0,0 +1,90 @@
+package authclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/barugoo/oscillo-auth/api/grpc"
+)
+
+type cacheEntry struct {
+	claims    *Claims
+	expiresAt time.Time
+}
+
+type remoteVerifier struct {
+	client  pb.AuthClient
+	options *options
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]*cacheEntry
+}
+
+func newRemoteVerifier(client pb.AuthClient, o *options) *remoteVerifier {
+	return &remoteVerifier{
+		client:  client,
+		options: o,
+		cache:   make(map[[sha256.Size]byte]*cacheEntry),
+	}
+}
+
+func (v *remoteVerifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	key := sha256.Sum256([]byte(token))
+	now := time.Now()
+
+	v.mu.Lock()
+	entry, ok := v.cache[key]
+	v.mu.Unlock()
+
+	if ok && now.Before(entry.expiresAt) {
+		return entry.claims, nil
+	}
+
+	resp, err := v.client.ValidateToken(ctx, &pb.ValidateTokenRequest{
+		Token: token,
+	})
+	if status.Code(err) == codes.Unauthenticated {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, status.Convert(err).Message())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	claims := claimsFromProto(resp.Claims)
+	v.store(key, claims, now)
+	return claims, nil
+}
+
+// store never keeps a result past the token's own expiry, and drops expired
+// entries once the cache is full.
+func (v *remoteVerifier) store(key [sha256.Size]byte, claims *Claims, now time.Time) {
+	expiresAt := now.Add(v.options.cacheTTL)
+	if exp := time.Unix(claims.ExpiresAt, 0); exp.Before(expiresAt) {
+		expiresAt = exp
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if len(v.cache) >= v.options.cacheSize {
+		for k, entry := range v.cache {
+			if !now.Before(entry.expiresAt) {
+				delete(v.cache, k)
+			}
+		}
+	}
+	if len(v.cache) >= v.options.cacheSize {
+		return
+	}
+
+	v.cache[key] = &cacheEntry{
+		claims:    claims,
+		expiresAt: expiresAt,
+	}
+}